@@ -0,0 +1,247 @@
+package day01
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/manning0218/adventOfCode/go/graph"
+)
+
+var (
+	ErrUnknownDial   = errors.New("unknown dial")
+	ErrDuplicateDial = errors.New("dial already exists")
+	ErrGearCycle     = errors.New("gears would form a cycle")
+)
+
+// Counts records how often a dial has pointed at one watched position.
+type Counts struct {
+	// Landings counts the turns of the dial that stopped on the position.
+	Landings int
+	// Passes counts the clicks that pointed at the position, including the
+	// last click of a turn that stops there.
+	Passes int
+}
+
+// Gear makes turning the dial it belongs to turn To by Ratio clicks per
+// click. A negative ratio turns To the opposite way.
+type Gear struct {
+	To    string
+	Ratio int
+}
+
+// CombinationLock is a set of named dials, possibly of different sizes,
+// that may be geared together. Gears form no cycles, so every rotation
+// ends.
+type CombinationLock struct {
+	dials map[string]*lockDial
+}
+
+// lockDial is one dial of a CombinationLock.
+type lockDial struct {
+	dial    Dial
+	gears   []Gear
+	watched map[int]*Counts
+}
+
+// NewCombinationLock returns a lock with no dials.
+func NewCombinationLock() *CombinationLock {
+	return &CombinationLock{dials: map[string]*lockDial{}}
+}
+
+// AddDial adds a dial of the given size pointing at start.
+func (l *CombinationLock) AddDial(name string, size, start int) error {
+	if _, ok := l.dials[name]; ok {
+		return fmt.Errorf("%w: %q", ErrDuplicateDial, name)
+	}
+	if size <= 0 {
+		return fmt.Errorf("dial %q: size %d is not positive", name, size)
+	}
+	l.dials[name] = &lockDial{dial: NewDial(size, start), watched: map[int]*Counts{}}
+	return nil
+}
+
+// Dials returns the names of the dials in sorted order.
+func (l *CombinationLock) Dials() []string {
+	names := make([]string, 0, len(l.dials))
+	for name := range l.dials {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (l *CombinationLock) lookup(name string) (*lockDial, error) {
+	d, ok := l.dials[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownDial, name)
+	}
+	return d, nil
+}
+
+// Neighbors implements graph.Graph over dial names, with an edge along each
+// gear.
+func (l *CombinationLock) Neighbors(name string) []graph.Edge[string] {
+	d, ok := l.dials[name]
+	if !ok {
+		return nil
+	}
+	var edges []graph.Edge[string]
+	for _, g := range d.gears {
+		edges = append(edges, graph.Edge[string]{To: g.To, Cost: 1})
+	}
+	return edges
+}
+
+// AddGear makes every click of dial from turn dial to by ratio clicks.
+func (l *CombinationLock) AddGear(from, to string, ratio int) error {
+	d, err := l.lookup(from)
+	if err != nil {
+		return err
+	}
+	if _, err := l.lookup(to); err != nil {
+		return err
+	}
+	if ratio == 0 || ratio == math.MinInt {
+		return fmt.Errorf("gear %q to %q: ratio %d is out of range", from, to, ratio)
+	}
+	if graph.DFS[string](l, []string{to}, func(n string) bool { return n == from }).Found {
+		return fmt.Errorf("%w: %q to %q", ErrGearCycle, from, to)
+	}
+	d.gears = append(d.gears, Gear{To: to, Ratio: ratio})
+	return nil
+}
+
+// Watch starts counting landings on and passes over positions of a dial.
+// Watching a position twice keeps its counts.
+func (l *CombinationLock) Watch(name string, positions ...int) error {
+	d, err := l.lookup(name)
+	if err != nil {
+		return err
+	}
+	for _, p := range positions {
+		p = mod(p, d.dial.size)
+		if _, ok := d.watched[p]; !ok {
+			d.watched[p] = &Counts{}
+		}
+	}
+	return nil
+}
+
+// Rotate turns the named dial and, through its gears, every dial geared to
+// it. Each dial a rotation reaches counts as turned once per gear path. If
+// a geared turn or a count would overflow, Rotate returns ErrStepsOverflow
+// and leaves the lock unchanged.
+func (l *CombinationLock) Rotate(name string, r Rotation) error {
+	if _, err := l.lookup(name); err != nil {
+		return err
+	}
+	clicks := r.Steps
+	if r.Direction == Left {
+		clicks = -clicks
+	}
+	turns, err := l.plan(nil, name, clicks)
+	if err != nil {
+		return err
+	}
+
+	// Apply the turns to copies so that an overflow part way through
+	// leaves every dial as it was.
+	staged := map[string]*lockDial{}
+	for _, t := range turns {
+		d, ok := staged[t.name]
+		if !ok {
+			d = l.dials[t.name].clone()
+			staged[t.name] = d
+		}
+		if err := d.turn(t.clicks); err != nil {
+			return fmt.Errorf("dial %q: %w", t.name, err)
+		}
+	}
+	maps.Copy(l.dials, staged)
+	return nil
+}
+
+// turn is one dial's share of a rotation.
+type turn struct {
+	name   string
+	clicks int
+}
+
+// plan appends the turn of the named dial and, depth first, of every dial
+// geared to it.
+func (l *CombinationLock) plan(turns []turn, name string, clicks int) ([]turn, error) {
+	turns = append(turns, turn{name, clicks})
+	for _, g := range l.dials[name].gears {
+		if abs(clicks) > math.MaxInt/abs(g.Ratio) {
+			return nil, fmt.Errorf("%w: %d clicks of %q through gear ratio %d to %q",
+				ErrStepsOverflow, clicks, name, g.Ratio, g.To)
+		}
+		var err error
+		if turns, err = l.plan(turns, g.To, clicks*g.Ratio); err != nil {
+			return nil, err
+		}
+	}
+	return turns, nil
+}
+
+// clone returns a copy of d whose counts can change independently.
+func (d *lockDial) clone() *lockDial {
+	c := &lockDial{dial: d.dial, gears: d.gears, watched: make(map[int]*Counts, len(d.watched))}
+	for p, counts := range d.watched {
+		copied := *counts
+		c.watched[p] = &copied
+	}
+	return c
+}
+
+// turn moves the dial by clicks, rightwards if positive.
+func (d *lockDial) turn(clicks int) error {
+	for p, c := range d.watched {
+		passes, err := sum(c.Passes, hits(d.dial.size, d.dial.pos, p, clicks))
+		if err != nil {
+			return err
+		}
+		c.Passes = passes
+	}
+	if clicks < 0 {
+		d.dial = d.dial.MoveLeft(-clicks)
+	} else {
+		d.dial = d.dial.MoveRight(clicks)
+	}
+	if c, ok := d.watched[d.dial.pos]; ok {
+		c.Landings++
+	}
+	return nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Position returns the number the named dial points at.
+func (l *CombinationLock) Position(name string) (int, error) {
+	d, err := l.lookup(name)
+	if err != nil {
+		return 0, err
+	}
+	return d.dial.pos, nil
+}
+
+// Counts returns the counts for every watched position of the named dial.
+func (l *CombinationLock) Counts(name string) (map[int]Counts, error) {
+	d, err := l.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int]Counts, len(d.watched))
+	for p, c := range d.watched {
+		counts[p] = *c
+	}
+	return counts, nil
+}
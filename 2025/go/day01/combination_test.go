@@ -0,0 +1,206 @@
+package day01
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"testing"
+)
+
+// bruteCounts turns a dial one click at a time, counting visits to target.
+func bruteCounts(size, pos, target int, rotations []Rotation) Counts {
+	var c Counts
+	for _, r := range rotations {
+		step := 1
+		if r.Direction == Left {
+			step = -1
+		}
+		for range r.Steps {
+			pos = mod(pos+step, size)
+			if pos == target {
+				c.Passes++
+			}
+		}
+		if pos == target {
+			c.Landings++
+		}
+	}
+	return c
+}
+
+func TestCombinationLockSingleDial(t *testing.T) {
+	lock := NewCombinationLock()
+	if err := lock.AddDial("a", dialSize, dialStart); err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.Watch("a", 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []Rotation{{Left, 68}, {Left, 30}, {Right, 48}, {Left, 5}, {Right, 60}, {Left, 55}, {Left, 1}, {Left, 99}, {Right, 14}, {Left, 82}} {
+		if err := lock.Rotate("a", r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	counts, err := lock.Counts("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (Counts{Landings: 3, Passes: 6}); counts[0] != expected {
+		t.Errorf("Counts(a)[0] = %+v; want %+v", counts[0], expected)
+	}
+}
+
+func TestCombinationLockWatchedPositions(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	rotations := make([]Rotation, 200)
+	for i := range rotations {
+		rotations[i] = Rotation{Direction: Left, Steps: rng.IntN(40)}
+		if rng.IntN(2) == 0 {
+			rotations[i].Direction = Right
+		}
+	}
+
+	lock := NewCombinationLock()
+	if err := lock.AddDial("d", 13, 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.Watch("d", 0, 4, 12, 25); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rotations {
+		if err := lock.Rotate("d", r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	counts, err := lock.Counts("d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[int]Counts{}
+	for _, target := range []int{0, 4, 12} {
+		expected[target] = bruteCounts(13, 4, target, rotations)
+	}
+	if !maps.Equal(counts, expected) {
+		t.Errorf("Counts(d) = %v; want %v", counts, expected)
+	}
+}
+
+func TestCombinationLockGears(t *testing.T) {
+	lock := NewCombinationLock()
+	for _, d := range []struct {
+		name        string
+		size, start int
+	}{{"a", 10, 0}, {"b", 5, 0}, {"c", 7, 0}, {"d", 100, 0}} {
+		if err := lock.AddDial(d.name, d.size, d.start); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, g := range []struct {
+		from, to string
+		ratio    int
+	}{{"a", "b", 2}, {"a", "c", -1}, {"b", "d", 3}, {"c", "d", 1}} {
+		if err := lock.AddGear(g.from, g.to, g.ratio); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := lock.Watch("d", 15); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := lock.Rotate("a", Rotation{Right, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a turns 3 right, b 6 right, c 3 left; d turns 18 right via b and
+	// then 3 left via c.
+	expected := map[string]int{"a": 3, "b": 1, "c": 4, "d": 15}
+	for _, name := range lock.Dials() {
+		if got, _ := lock.Position(name); got != expected[name] {
+			t.Errorf("Position(%s) = %d; want %d", name, got, expected[name])
+		}
+	}
+	counts, _ := lock.Counts("d")
+	if expected := (Counts{Landings: 1, Passes: 2}); counts[15] != expected {
+		t.Errorf("Counts(d)[15] = %+v; want %+v", counts[15], expected)
+	}
+}
+
+func TestCombinationLockErrors(t *testing.T) {
+	lock := NewCombinationLock()
+	for _, name := range []string{"a", "b", "c"} {
+		if err := lock.AddDial(name, 10, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := lock.AddGear("a", "b", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.AddGear("b", "c", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		err    error
+		target error
+	}{
+		{"duplicate dial", lock.AddDial("a", 5, 0), ErrDuplicateDial},
+		{"gear cycle", lock.AddGear("c", "a", 1), ErrGearCycle},
+		{"self gear", lock.AddGear("a", "a", 1), ErrGearCycle},
+		{"unknown gear dial", lock.AddGear("a", "z", 1), ErrUnknownDial},
+		{"unknown rotate", lock.Rotate("z", Rotation{Left, 1}), ErrUnknownDial},
+		{"unknown watch", lock.Watch("z", 1), ErrUnknownDial},
+	}
+
+	for _, tt := range tests {
+		if !errors.Is(tt.err, tt.target) {
+			t.Errorf("%s: error = %v; want %v", tt.name, tt.err, tt.target)
+		}
+	}
+	if err := lock.AddGear("a", "c", 0); err == nil {
+		t.Error("AddGear() accepted a zero ratio")
+	}
+}
+
+func TestCombinationLockGearOverflow(t *testing.T) {
+	lock := NewCombinationLock()
+	names := []string{"a", "b", "c", "d", "e"}
+	for _, name := range names {
+		if err := lock.AddDial(name, 100, 0); err != nil {
+			t.Fatal(err)
+		}
+		if err := lock.Watch(name, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Each gear multiplies by a thousand, so e turns 10^12 times as far as a.
+	for i := range len(names) - 1 {
+		if err := lock.AddGear(names[i], names[i+1], 1_000); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A turn small enough to reach d but not e must leave every dial alone.
+	err := lock.Rotate("a", Rotation{Right, 10_000_000})
+	if !errors.Is(err, ErrStepsOverflow) {
+		t.Fatalf("Rotate() error = %v; want %v", err, ErrStepsOverflow)
+	}
+	for _, name := range names {
+		pos, _ := lock.Position(name)
+		counts, _ := lock.Counts(name)
+		if pos != 0 || counts[0] != (Counts{}) {
+			t.Errorf("dial %s at %d with %+v after a failed rotation; want untouched", name, pos, counts[0])
+		}
+	}
+
+	// Up to the product limit the chain turns normally.
+	if err := lock.Rotate("a", Rotation{Left, 1_000_000}); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+	counts, _ := lock.Counts("e")
+	if expected := (Counts{Landings: 1, Passes: 1_000_000_000_000_000_000 / 100}); counts[0] != expected {
+		t.Errorf("Counts(e)[0] = %+v; want %+v", counts[0], expected)
+	}
+}
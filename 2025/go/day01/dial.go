@@ -0,0 +1,90 @@
+package day01
+
+// Dial is a circular dial numbered 0 to size-1. Unlike Lock it stores only
+// its position, so a move costs the same however many clicks it covers.
+//
+// A Dial also counts how often it has pointed at zero. Dials are values, so
+// each copy keeps its own counts.
+type Dial struct {
+	size     int
+	pos      int
+	landings int
+	passes   int
+}
+
+// NewDial returns a dial of the given size pointing at start.
+func NewDial(size, start int) Dial {
+	return Dial{size: size, pos: mod(start, size)}
+}
+
+// Position returns the number the dial points at.
+func (d Dial) Position() int {
+	return d.pos
+}
+
+// Landings returns how many rotations, empty ones included, left the dial
+// at zero.
+func (d Dial) Landings() int {
+	return d.landings
+}
+
+// Passes returns how many clicks pointed the dial at zero, counting the
+// last click of a rotation that lands there.
+func (d Dial) Passes() int {
+	return d.passes
+}
+
+// MoveLeft turns the dial steps clicks towards lower numbers.
+// Non-positive steps leave it where it is.
+func (d Dial) MoveLeft(steps int) Dial {
+	if steps > 0 {
+		d.passes += hits(d.size, d.pos, 0, -steps)
+		d.pos = mod(d.pos-steps%d.size, d.size)
+	}
+	return d.land()
+}
+
+// MoveRight turns the dial steps clicks towards higher numbers.
+// Non-positive steps leave it where it is.
+func (d Dial) MoveRight(steps int) Dial {
+	if steps > 0 {
+		d.passes += hits(d.size, d.pos, 0, steps)
+		d.pos = mod(d.pos+steps%d.size, d.size)
+	}
+	return d.land()
+}
+
+// land records the end of a rotation.
+func (d Dial) land() Dial {
+	if d.pos == 0 {
+		d.landings++
+	}
+	return d
+}
+
+// IsMagicNumber reports whether the dial points at any of magicNumbers.
+func (d Dial) IsMagicNumber(magicNumbers ...int) bool {
+	for _, num := range magicNumbers {
+		if d.pos == num {
+			return true
+		}
+	}
+	return false
+}
+
+// hits counts the clicks that point a dial of the given size at target
+// while it turns from pos by clicks, rightwards if positive and leftwards if
+// negative. It cannot overflow for any clicks other than math.MinInt.
+func hits(size, pos, target, clicks int) int {
+	// offset is how far the dial already is past its last visit to target.
+	offset := mod(pos-target, size)
+	if clicks < 0 {
+		offset, clicks = mod(target-pos, size), -clicks
+	}
+	return clicks/size + (offset+clicks%size)/size
+}
+
+// mod returns a modulo n in the range [0, n).
+func mod(a, n int) int {
+	return (a%n + n) % n
+}
@@ -0,0 +1,38 @@
+package day01
+
+import "testing"
+
+const hugeSteps = 10_000_000
+
+func BenchmarkLockHugeRotation(b *testing.B) {
+	lock := NewLock(100, 50)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		lock, _ = lock.MoveRight(hugeSteps)
+		lock, _ = lock.MoveLeft(hugeSteps + 1)
+	}
+}
+
+func BenchmarkDialHugeRotation(b *testing.B) {
+	dial := NewDial(100, 50)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		dial = dial.MoveRight(hugeSteps).MoveLeft(hugeSteps + 1)
+	}
+}
+
+func BenchmarkScriptHugeRepeat(b *testing.B) {
+	script, err := ParseScript("((R10 L3)*1000000 L99)*1000000")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := script.Run(NewDial(dialSize, dialStart)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
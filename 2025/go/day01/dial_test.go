@@ -0,0 +1,68 @@
+package day01
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestDialMatchesLock(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for _, size := range []int{1, 2, 7, 100} {
+		start := rng.IntN(size)
+		lock, dial := NewLock(size, start), NewDial(size, start)
+		passes := 0
+
+		for i := 0; i < 500; i++ {
+			steps := rng.IntN(5*size) - 1
+			left := rng.IntN(2) == 0
+
+			var zeros int
+			if left {
+				lock, zeros = lock.MoveLeft(steps)
+				dial = dial.MoveLeft(steps)
+			} else {
+				lock, zeros = lock.MoveRight(steps)
+				dial = dial.MoveRight(steps)
+			}
+			passes += zeros
+
+			if dial.Position() != lock.Val || dial.Passes() != passes {
+				t.Fatalf("size %d move %d (left %t, %d steps): dial at %d with %d passes; lock at %d with %d",
+					size, i, left, steps, dial.Position(), dial.Passes(), lock.Val, passes)
+			}
+		}
+	}
+}
+
+func TestDial(t *testing.T) {
+	tests := []struct {
+		name     string
+		move     func(Dial) Dial
+		position int
+		landings int
+		passes   int
+	}{
+		{"right onto zero", func(d Dial) Dial { return d.MoveRight(50) }, 0, 1, 1},
+		{"left onto zero", func(d Dial) Dial { return d.MoveLeft(50) }, 0, 1, 1},
+		{"right many turns", func(d Dial) Dial { return d.MoveRight(1000) }, 50, 0, 10},
+		{"left from zero", func(d Dial) Dial { return d.MoveLeft(50).MoveLeft(100) }, 0, 2, 2},
+		{"empty rotation at zero", func(d Dial) Dial { return d.MoveLeft(50).MoveRight(0) }, 0, 2, 1},
+		{"short of zero", func(d Dial) Dial { return d.MoveLeft(49) }, 1, 0, 0},
+	}
+
+	for _, tt := range tests {
+		d := tt.move(NewDial(100, 50))
+		if d.Position() != tt.position || d.Landings() != tt.landings || d.Passes() != tt.passes {
+			t.Errorf("%s: at %d with %d landings and %d passes; want %d, %d and %d",
+				tt.name, d.Position(), d.Landings(), d.Passes(), tt.position, tt.landings, tt.passes)
+		}
+	}
+}
+
+func TestDialsAreIndependent(t *testing.T) {
+	a := NewDial(100, 50).MoveRight(50)
+	b := a.MoveRight(100)
+	if a.Passes() != 1 || b.Passes() != 2 {
+		t.Errorf("Passes() = %d and %d; want 1 and 2", a.Passes(), b.Passes())
+	}
+}
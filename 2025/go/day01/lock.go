@@ -8,12 +8,6 @@ type Lock struct {
 	Next *Lock
 }
 
-var password int = 0
-
-func ResetPassword() {
-	password = 0
-}
-
 func NewLock(maxVal, startingPosition int) *Lock {
 	head := &Lock{Val: 0}
 	current := head
@@ -40,30 +34,30 @@ func PrintLock(l *Lock, size int) {
 	}
 }
 
-func (l *Lock) MoveLeft(steps int) *Lock {
-	current := l
+// MoveLeft walks steps nodes and returns the node it stops on together with
+// the number of steps that landed on zero.
+func (l *Lock) MoveLeft(steps int) (*Lock, int) {
+	current, zeros := l, 0
 	for i := 0; i < steps; i++ {
 		current = current.Prev
 		if current.Val == 0 {
-			password++
+			zeros++
 		}
 	}
-	return current
+	return current, zeros
 }
 
-func (l *Lock) MoveRight(steps int) *Lock {
-	current := l
+// MoveRight walks steps nodes and returns the node it stops on together with
+// the number of steps that landed on zero.
+func (l *Lock) MoveRight(steps int) (*Lock, int) {
+	current, zeros := l, 0
 	for i := 0; i < steps; i++ {
 		current = current.Next
 		if current.Val == 0 {
-			password++
+			zeros++
 		}
 	}
-	return current
-}
-
-func GetPassword() int {
-	return password
+	return current, zeros
 }
 
 func (l *Lock) IsMagicNumber(magicNumbers ...int) bool {
@@ -0,0 +1,118 @@
+package day01
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/manning0218/adventOfCode/go/parse"
+)
+
+var (
+	ErrNegativeSteps = errors.New("negative step count")
+	ErrStepsOverflow = errors.New("step count overflows")
+)
+
+// Direction is the way a rotation turns the dial.
+type Direction byte
+
+const (
+	Left  Direction = 'L'
+	Right Direction = 'R'
+)
+
+// Rotation turns the dial Steps clicks in Direction.
+type Rotation struct {
+	Direction Direction
+	Steps     int
+}
+
+func (r Rotation) String() string {
+	return fmt.Sprintf("%c%d", r.Direction, r.Steps)
+}
+
+// Apply returns d turned by r.
+func (r Rotation) Apply(d Dial) Dial {
+	if r.Direction == Left {
+		return d.MoveLeft(r.Steps)
+	}
+	return d.MoveRight(r.Steps)
+}
+
+// ParseRotations reads one rotation per line, such as "L68" or "r 5".
+// Directions may be either case and blank lines are skipped. Errors are
+// *parse.Error values naming the offending line and column.
+func ParseRotations(r io.Reader) ([]Rotation, error) {
+	var rotations []Rotation
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		rotation, column, err := parseRotation(scanner.Text())
+		if err != nil {
+			return rotations, &parse.Error{Line: line, Column: column, Err: err}
+		}
+		rotations = append(rotations, rotation)
+	}
+	if err := scanner.Err(); err != nil {
+		return rotations, fmt.Errorf("reading rotations: %w", err)
+	}
+	return rotations, nil
+}
+
+// parseRotation parses a single non-blank rotation. On failure it also
+// returns the 1-based column of the problem.
+func parseRotation(line string) (Rotation, int, error) {
+	runes := []rune(line)
+	i := 0
+	skipSpace := func() {
+		for i < len(runes) && unicode.IsSpace(runes[i]) {
+			i++
+		}
+	}
+
+	skipSpace()
+	var rotation Rotation
+	switch unicode.ToUpper(runes[i]) {
+	case 'L':
+		rotation.Direction = Left
+	case 'R':
+		rotation.Direction = Right
+	default:
+		return Rotation{}, i + 1, fmt.Errorf("unknown direction %q", runes[i])
+	}
+	i++
+
+	skipSpace()
+	if i == len(runes) {
+		return Rotation{}, i + 1, errors.New("missing step count")
+	}
+	if runes[i] == '-' {
+		return Rotation{}, i + 1, ErrNegativeSteps
+	}
+	start := i
+	for i < len(runes) && '0' <= runes[i] && runes[i] <= '9' {
+		i++
+	}
+	if i == start {
+		return Rotation{}, i + 1, fmt.Errorf("unexpected %q, want a step count", runes[i])
+	}
+	digits := string(runes[start:i])
+
+	skipSpace()
+	if i < len(runes) {
+		return Rotation{}, i + 1, fmt.Errorf("unexpected %q after step count", runes[i])
+	}
+
+	steps, err := strconv.Atoi(digits)
+	if err != nil {
+		return Rotation{}, start + 1, fmt.Errorf("%w: %s", ErrStepsOverflow, digits)
+	}
+	rotation.Steps = steps
+	return rotation, 0, nil
+}
@@ -0,0 +1,81 @@
+package day01
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/parse"
+)
+
+func TestParseRotations(t *testing.T) {
+	input := "L68\nr48\n  l 5  \n\nR\t60\n"
+	expected := []Rotation{{Left, 68}, {Right, 48}, {Left, 5}, {Right, 60}}
+
+	got, err := ParseRotations(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseRotations() error = %v", err)
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("ParseRotations() = %v; want %v", got, expected)
+	}
+}
+
+func TestParseRotationsErrors(t *testing.T) {
+	tests := []struct {
+		input  string
+		line   int
+		column int
+		target error
+	}{
+		{"L1\nX5", 2, 1, nil},
+		{"L1\n\n  R", 3, 4, nil},
+		{"R-5", 1, 2, ErrNegativeSteps},
+		{"L 1x", 1, 4, nil},
+		{"L 1 2", 1, 5, nil},
+		{"R99999999999999999999", 1, 2, ErrStepsOverflow},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseRotations(strings.NewReader(tt.input))
+		var parseErr *parse.Error
+		if !errors.As(err, &parseErr) {
+			t.Errorf("ParseRotations(%q) error = %v; want *parse.Error", tt.input, err)
+			continue
+		}
+		if parseErr.Line != tt.line || parseErr.Column != tt.column {
+			t.Errorf("ParseRotations(%q) error at %d:%d; want %d:%d (%v)",
+				tt.input, parseErr.Line, parseErr.Column, tt.line, tt.column, err)
+		}
+		if tt.target != nil && !errors.Is(err, tt.target) {
+			t.Errorf("ParseRotations(%q) error = %v; want %v", tt.input, err, tt.target)
+		}
+	}
+}
+
+func TestParseRotationsLargeSteps(t *testing.T) {
+	tests := []struct {
+		input    string
+		position int
+		passes   int
+	}{
+		// The largest step count that parses must not wrap the pass count.
+		{"R9223372036854775807", 57, 92233720368547758},
+		{"L9223372036854775807", 43, 92233720368547758},
+		{"R9223372036854775807\nL9223372036854775807", 50, 184467440737095516},
+	}
+
+	for _, tt := range tests {
+		rotations, err := ParseRotations(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("ParseRotations(%q) error = %v", tt.input, err)
+			continue
+		}
+		d := Rotations(rotations).Dial()
+		if d.Position() != tt.position || d.Passes() != tt.passes {
+			t.Errorf("%q: at %d with %d passes; want %d with %d",
+				tt.input, d.Position(), d.Passes(), tt.position, tt.passes)
+		}
+	}
+}
@@ -0,0 +1,482 @@
+package day01
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/manning0218/adventOfCode/go/parse"
+)
+
+// A rotation script is a sequence of rotations, with parentheses to group
+// them and a repeat count written *N or xN after a rotation or group:
+//
+//	script   = { term }
+//	term     = ( rotation | "(" script ")" ) [ ( "*" | "x" ) count ]
+//	rotation = ( "L" | "R" ) count
+//
+// Terms are separated by white space, so a puzzle input is itself a script.
+// Directions and the x may be either case.
+
+// Instruction is one term of a script: a Rotation or a Repeat.
+type Instruction interface {
+	fmt.Stringer
+	// Run returns d after the instruction has turned it. It fails with
+	// ErrStepsOverflow if a count would no longer fit in an int.
+	Run(d Dial) (Dial, error)
+	// shift returns the net clicks, modulo size, the instruction turns a
+	// dial rightwards.
+	shift(size int) int
+}
+
+// Script is a parsed rotation script.
+type Script []Instruction
+
+// Repeat runs Body Count times.
+type Repeat struct {
+	Body  Script
+	Count int
+}
+
+// maxRepeatDial bounds the dial size Repeat.Run accepts, so that the
+// products of two residues it forms fit in an int.
+const maxRepeatDial = 1 << 30
+
+// Run runs every instruction in order.
+func (s Script) Run(d Dial) (Dial, error) {
+	for _, in := range s {
+		var err error
+		if d, err = in.Run(d); err != nil {
+			return d, err
+		}
+	}
+	return d, nil
+}
+
+func (s Script) String() string {
+	terms := make([]string, len(s))
+	for i, in := range s {
+		terms[i] = in.String()
+	}
+	return strings.Join(terms, " ")
+}
+
+func (s Script) shift(size int) int {
+	total := 0
+	for _, in := range s {
+		total = (total + in.shift(size)) % size
+	}
+	return total
+}
+
+// flat reports whether s holds only rotations.
+func (s Script) flat() bool {
+	for _, in := range s {
+		if _, ok := in.(Rotation); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Run turns d as if Body ran Count times, without unrolling the loop. A body
+// of plain rotations is counted in closed form. A body that itself repeats
+// runs at most once around the dial, as each lap adds the same counts.
+func (r Repeat) Run(d Dial) (Dial, error) {
+	if d.size > maxRepeatDial {
+		return d, fmt.Errorf("%w: dial of size %d is too large to repeat on", ErrStepsOverflow, d.size)
+	}
+	if r.Count <= 0 {
+		return d, nil
+	}
+	if r.Body.flat() {
+		return r.runFlat(d)
+	}
+	return r.runLaps(d)
+}
+
+func (r Repeat) String() string {
+	return fmt.Sprintf("(%s)*%d", r.Body, r.Count)
+}
+
+func (r Repeat) shift(size int) int {
+	return r.Count % size * r.Body.shift(size) % size
+}
+
+// runFlat counts a repeated body of rotations arithmetically. Iteration i
+// starts the body at d.pos plus i times the body's shift, so each rotation's
+// passes and landings over all iterations are counts over an arithmetic
+// progression modulo the dial size.
+func (r Repeat) runFlat(d Dial) (Dial, error) {
+	n, k := d.size, r.Count
+	shift := r.Body.shift(n)
+	var landings, passes int
+	before := d.pos
+	for _, in := range r.Body {
+		rot := in.(Rotation)
+		if rot.Steps > 0 {
+			// offset is how far the rotation starts past zero in its own
+			// direction, as in hits.
+			offset, step := before, shift
+			if rot.Direction == Left {
+				offset, step = mod(-before, n), mod(-shift, n)
+			}
+			full, err := mul(k, rot.Steps/n)
+			if err != nil {
+				return d, err
+			}
+			partial := 0
+			if rest := rot.Steps % n; rest > 0 {
+				if partial, err = countAtLeast(k, n, offset, step, n-rest); err != nil {
+					return d, err
+				}
+			}
+			if passes, err = sum(passes, full, partial); err != nil {
+				return d, err
+			}
+		}
+		before = (before + rot.shift(n)) % n
+		var err error
+		if landings, err = sum(landings, countZeros(k, n, before, shift)); err != nil {
+			return d, err
+		}
+	}
+	d.pos = (d.pos + r.shift(n)) % n
+	return d.add(landings, passes)
+}
+
+// runLaps runs a body containing repeats one iteration at a time, but at
+// most once around the dial: after enough iterations for the body's shifts
+// to add up to a whole turn the dial is back where it started, so the
+// remaining full laps repeat the same counts.
+func (r Repeat) runLaps(d Dial) (Dial, error) {
+	lap := d.size / gcd(r.Body.shift(d.size), d.size)
+	laps, rest := r.Count/lap, r.Count%lap
+	var err error
+	if laps > 0 {
+		start := d
+		for range lap {
+			if d, err = r.Body.Run(d); err != nil {
+				return d, err
+			}
+		}
+		landings, err := mul(laps-1, d.landings-start.landings)
+		if err != nil {
+			return d, err
+		}
+		passes, err := mul(laps-1, d.passes-start.passes)
+		if err != nil {
+			return d, err
+		}
+		if d, err = d.add(landings, passes); err != nil {
+			return d, err
+		}
+	}
+	for range rest {
+		if d, err = r.Body.Run(d); err != nil {
+			return d, err
+		}
+	}
+	return d, nil
+}
+
+// Run turns d by r, failing if its pass count would overflow.
+func (r Rotation) Run(d Dial) (Dial, error) {
+	passes := 0
+	if r.Steps > 0 {
+		clicks := r.Steps
+		if r.Direction == Left {
+			clicks = -clicks
+		}
+		passes = hits(d.size, d.pos, 0, clicks)
+	}
+	if _, err := sum(d.passes, passes); err != nil {
+		return d, err
+	}
+	return r.Apply(d), nil
+}
+
+func (r Rotation) shift(size int) int {
+	if r.Steps <= 0 {
+		return 0
+	}
+	if r.Direction == Left {
+		return mod(-r.Steps, size)
+	}
+	return r.Steps % size
+}
+
+// add adds landings and passes to the dial's counts.
+func (d Dial) add(landings, passes int) (Dial, error) {
+	var err error
+	if d.landings, err = sum(d.landings, landings); err != nil {
+		return d, err
+	}
+	if d.passes, err = sum(d.passes, passes); err != nil {
+		return d, err
+	}
+	return d, nil
+}
+
+// countAtLeast counts the i in [0, k) with (a + i*step) mod n >= lo, for
+// a and step in [0, n) and lo in (0, n]. The progression cycles every
+// n/gcd(step, n) terms through the values congruent to a modulo the gcd.
+func countAtLeast(k, n, a, step, lo int) (int, error) {
+	g := gcd(step, n)
+	cycle := n / g
+	// In one cycle the values are a%g, a%g+g, ..., of which those from the
+	// first one at or above lo onwards count.
+	first := max(0, (lo-a%g+g-1)/g)
+	perCycle := max(0, cycle-first)
+	full, err := mul(k/cycle, perCycle)
+	if err != nil {
+		return 0, err
+	}
+	// (x mod n) >= lo exactly when floor((x+n-lo)/n) - floor(x/n) is 1.
+	rest := k % cycle
+	partial := floorSum(rest, n, step, a+n-lo) - floorSum(rest, n, step, a)
+	return sum(full, partial)
+}
+
+// countZeros counts the i in [0, k) with (a + i*step) mod n == 0, for a
+// and step in [0, n).
+func countZeros(k, n, a, step int) int {
+	g := gcd(step, n)
+	if a%g != 0 {
+		return 0
+	}
+	cycle := n / g
+	// Solve i*(step/g) = -a/g modulo cycle for the first such i.
+	first := 0
+	if cycle > 1 {
+		first = mod(-a/g, cycle) * inverse(step/g%cycle, cycle) % cycle
+	}
+	if first >= k {
+		return 0
+	}
+	return (k-1-first)/cycle + 1
+}
+
+// floorSum returns the sum of floor((a*i + b)/m) for i in [0, n), for
+// non-negative a and b. Its intermediate values stay below about n*(a+b+m),
+// which the callers keep well inside an int.
+func floorSum(n, m, a, b int) int {
+	total := 0
+	for n > 0 {
+		if a >= m {
+			total += n * (n - 1) / 2 * (a / m)
+			a %= m
+		}
+		if b >= m {
+			total += n * (b / m)
+			b %= m
+		}
+		last := a*n + b
+		if last < m {
+			break
+		}
+		n, b, m, a = last/m, last%m, a, m
+	}
+	return total
+}
+
+// gcd returns the greatest common divisor of a and b, with gcd(0, b) == b.
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// inverse returns the inverse of a modulo m, for coprime a and m.
+func inverse(a, m int) int {
+	oldR, r := a, m
+	oldS, s := 1, 0
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldS, s = s, oldS-q*s
+	}
+	return mod(oldS, m)
+}
+
+// sum adds non-negative counts, failing with ErrStepsOverflow if the total
+// does not fit in an int.
+func sum(counts ...int) (int, error) {
+	total := 0
+	for _, c := range counts {
+		if c > math.MaxInt-total {
+			return 0, ErrStepsOverflow
+		}
+		total += c
+	}
+	return total, nil
+}
+
+// mul multiplies non-negative counts, failing with ErrStepsOverflow if the
+// product does not fit in an int.
+func mul(a, b int) (int, error) {
+	if a != 0 && b > math.MaxInt/a {
+		return 0, ErrStepsOverflow
+	}
+	return a * b, nil
+}
+
+// ParseScript parses a rotation script. Errors are *parse.Error values
+// naming the offending line and column.
+func ParseScript(src string) (Script, error) {
+	p := &scriptParser{runes: []rune(src), line: 1, column: 1}
+	return p.script(nil)
+}
+
+// scriptParser is a recursive descent parser over the runes of a script.
+type scriptParser struct {
+	runes        []rune
+	i            int
+	line, column int
+}
+
+// position is a place in the source, kept to report unclosed groups.
+type position struct{ line, column int }
+
+func (p *scriptParser) peek() rune {
+	if p.i == len(p.runes) {
+		return 0
+	}
+	return p.runes[p.i]
+}
+
+func (p *scriptParser) next() rune {
+	r := p.runes[p.i]
+	p.i++
+	if r == '\n' {
+		p.line, p.column = p.line+1, 1
+	} else {
+		p.column++
+	}
+	return r
+}
+
+func (p *scriptParser) skipSpace() {
+	for p.i < len(p.runes) && unicode.IsSpace(p.runes[p.i]) {
+		p.next()
+	}
+}
+
+func (p *scriptParser) errorAt(at position, err error) error {
+	return &parse.Error{Line: at.line, Column: at.column, Err: err}
+}
+
+func (p *scriptParser) here() position {
+	return position{p.line, p.column}
+}
+
+// script parses terms up to the end of input or, inside a group opened at
+// open, up to the matching ')'.
+func (p *scriptParser) script(open *position) (Script, error) {
+	var s Script
+	for {
+		p.skipSpace()
+		switch p.peek() {
+		case 0:
+			if open != nil {
+				return nil, p.errorAt(*open, errors.New("unclosed '('"))
+			}
+			return s, nil
+		case ')':
+			if open == nil {
+				return nil, p.errorAt(p.here(), errors.New("unexpected ')'"))
+			}
+			p.next()
+			return s, nil
+		}
+		in, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, in)
+	}
+}
+
+func (p *scriptParser) term() (Instruction, error) {
+	var in Instruction
+	if p.peek() == '(' {
+		open := p.here()
+		p.next()
+		body, err := p.script(&open)
+		if err != nil {
+			return nil, err
+		}
+		in = Repeat{Body: body, Count: 1}
+	} else {
+		r, err := p.rotation()
+		if err != nil {
+			return nil, err
+		}
+		in = r
+	}
+
+	p.skipSpace()
+	switch p.peek() {
+	case '*', 'x', 'X':
+		p.next()
+		p.skipSpace()
+		count, err := p.count()
+		if err != nil {
+			return nil, err
+		}
+		if group, ok := in.(Repeat); ok {
+			return Repeat{Body: group.Body, Count: count}, nil
+		}
+		return Repeat{Body: Script{in}, Count: count}, nil
+	}
+	return in, nil
+}
+
+func (p *scriptParser) rotation() (Rotation, error) {
+	var r Rotation
+	at := p.here()
+	switch unicode.ToUpper(p.peek()) {
+	case 'L':
+		r.Direction = Left
+	case 'R':
+		r.Direction = Right
+	default:
+		return Rotation{}, p.errorAt(at, fmt.Errorf("unexpected %q, want a rotation", p.peek()))
+	}
+	p.next()
+	p.skipSpace()
+	steps, err := p.count()
+	if err != nil {
+		return Rotation{}, err
+	}
+	r.Steps = steps
+	return r, nil
+}
+
+// count parses a non-negative integer.
+func (p *scriptParser) count() (int, error) {
+	at := p.here()
+	if p.peek() == '-' {
+		return 0, p.errorAt(at, ErrNegativeSteps)
+	}
+	start := p.i
+	for r := p.peek(); '0' <= r && r <= '9'; r = p.peek() {
+		p.next()
+	}
+	if p.i == start {
+		if p.peek() == 0 {
+			return 0, p.errorAt(at, errors.New("missing count"))
+		}
+		return 0, p.errorAt(at, fmt.Errorf("unexpected %q, want a count", p.peek()))
+	}
+	digits := string(p.runes[start:p.i])
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, p.errorAt(at, fmt.Errorf("%w: %s", ErrStepsOverflow, digits))
+	}
+	return n, nil
+}
@@ -0,0 +1,195 @@
+package day01
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/parse"
+)
+
+// unroll expands every Repeat in s into plain rotations.
+func unroll(s Script) []Rotation {
+	var out []Rotation
+	for _, in := range s {
+		switch in := in.(type) {
+		case Rotation:
+			out = append(out, in)
+		case Repeat:
+			body := unroll(in.Body)
+			for range in.Count {
+				out = append(out, body...)
+			}
+		}
+	}
+	return out
+}
+
+func TestScriptMatchesUnrolled(t *testing.T) {
+	scripts := []string{
+		"(R10 L3)*1000",
+		"R5x20",
+		"r5 X 20 l7",
+		"((R7)x3 L2)*5 (L150 R1)*0",
+		"(L68 L30 R48 L5 R60 L55 L1 L99 R14 L82)*37",
+		"((R33 (L1x7)*3)*11 L250)*9",
+	}
+
+	rng := rand.New(rand.NewPCG(3, 4))
+	for _, src := range scripts {
+		script, err := ParseScript(src)
+		if err != nil {
+			t.Fatalf("ParseScript(%q) error = %v", src, err)
+		}
+		for _, size := range []int{1, 7, 12, 100} {
+			start := rng.IntN(size)
+			got, err := script.Run(NewDial(size, start))
+			if err != nil {
+				t.Fatalf("%q on size %d: Run() error = %v", src, size, err)
+			}
+			expected := NewDial(size, start)
+			for _, r := range unroll(script) {
+				expected = r.Apply(expected)
+			}
+			if got != expected {
+				t.Errorf("%q on size %d from %d: %+v; want %+v", src, size, start, got, expected)
+			}
+		}
+	}
+}
+
+// randomScript builds a script of up to four terms, nesting groups up to
+// depth levels deep.
+func randomScript(rng *rand.Rand, depth int) Script {
+	var s Script
+	for range 1 + rng.IntN(4) {
+		if depth > 0 && rng.IntN(3) == 0 {
+			s = append(s, Repeat{Body: randomScript(rng, depth-1), Count: rng.IntN(30)})
+			continue
+		}
+		r := Rotation{Direction: Right, Steps: rng.IntN(40)}
+		if rng.IntN(2) == 0 {
+			r.Direction = Left
+		}
+		s = append(s, r)
+	}
+	return s
+}
+
+func TestRandomScriptsMatchUnrolled(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 8))
+	for range 500 {
+		script := randomScript(rng, 2)
+		size := 1 + rng.IntN(30)
+		start := rng.IntN(size)
+
+		got, err := script.Run(NewDial(size, start))
+		if err != nil {
+			t.Fatalf("%s on size %d: Run() error = %v", script, size, err)
+		}
+		expected := NewDial(size, start)
+		for _, r := range unroll(script) {
+			expected = r.Apply(expected)
+		}
+		if got != expected {
+			t.Fatalf("%s on size %d from %d: %+v; want %+v", script, size, start, got, expected)
+		}
+	}
+}
+
+func TestScriptLargeCounts(t *testing.T) {
+	tests := []struct {
+		src      string
+		landings int
+		passes   int
+		err      error
+	}{
+		// floor((50 + 99*MaxInt) / 100) passes still fits in an int.
+		{"(R99)*9223372036854775807", 92233720368547758, 9131138316486228049, nil},
+		{"(R99)*9223372036854775807 R9223372036854775807", 92233720368547758, 9223372036854775807, nil},
+		{"(R50 L100)*9223372036854775807", 9223372036854775807, 0, ErrStepsOverflow},
+		{"(R101)*9223372036854775807", 0, 0, ErrStepsOverflow},
+		{"((R99)*9223372036854775807)*2", 0, 0, ErrStepsOverflow},
+		{"(R99)*9223372036854775807 R9223372036854775807 R100", 0, 0, ErrStepsOverflow},
+	}
+
+	for _, tt := range tests {
+		script, err := ParseScript(tt.src)
+		if err != nil {
+			t.Fatalf("ParseScript(%q) error = %v", tt.src, err)
+		}
+		d, err := script.Run(NewDial(dialSize, dialStart))
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("%q: Run() error = %v; want %v", tt.src, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: Run() error = %v", tt.src, err)
+			continue
+		}
+		if d.Landings() != tt.landings || d.Passes() != tt.passes {
+			t.Errorf("%q: %d landings and %d passes; want %d and %d",
+				tt.src, d.Landings(), d.Passes(), tt.landings, tt.passes)
+		}
+	}
+}
+
+func TestScriptString(t *testing.T) {
+	script, err := ParseScript("L5 (R10 L3)*1000 R5x20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := script.String(), "L5 (R10 L3)*1000 (R5)*20"; got != expected {
+		t.Errorf("String() = %q; want %q", got, expected)
+	}
+}
+
+func TestParseScriptErrors(t *testing.T) {
+	tests := []struct {
+		src    string
+		line   int
+		column int
+	}{
+		{"R5 Q2", 1, 4},
+		{"R5\n(L1 R2", 2, 1},
+		{"R5)", 1, 3},
+		{"(R5)*", 1, 6},
+		{"L-3", 1, 2},
+		{"R1 x", 1, 5},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseScript(tt.src)
+		var parseErr *parse.Error
+		if !errors.As(err, &parseErr) {
+			t.Errorf("ParseScript(%q) error = %v; want *parse.Error", tt.src, err)
+			continue
+		}
+		if parseErr.Line != tt.line || parseErr.Column != tt.column {
+			t.Errorf("ParseScript(%q) error at %d:%d; want %d:%d (%v)",
+				tt.src, parseErr.Line, parseErr.Column, tt.line, tt.column, err)
+		}
+	}
+}
+
+func TestPuzzleInputIsAScript(t *testing.T) {
+	input := "L68\nL30\nR48\nL5\nR60\nL55\nL1\nL99\nR14\nL82\n"
+	script, err := ParseScript(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotations, err := ParseRotations(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := script.Run(NewDial(dialSize, dialStart))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := Rotations(rotations).Dial(); got != expected {
+		t.Errorf("script dial = %+v; want %+v", got, expected)
+	}
+}
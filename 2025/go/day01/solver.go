@@ -0,0 +1,70 @@
+package day01
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func init() {
+	solver.Register(2025, 1, Solver{})
+}
+
+// The dial has 100 positions and starts at 50.
+const (
+	dialSize  = 100
+	dialStart = 50
+)
+
+// Solver counts how often the dial points at zero.
+type Solver struct{}
+
+// Parse implements solver.Parser.
+func (Solver) Parse(input []string) (solver.Parsed, error) {
+	rotations, err := ParseRotations(strings.NewReader(strings.Join(input, "\n")))
+	if err != nil {
+		return nil, err
+	}
+	return Rotations(rotations), nil
+}
+
+// Part1 counts the rotations that leave the dial at zero.
+func (s Solver) Part1(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part1()
+}
+
+// Part2 counts every click that passes the dial over zero.
+func (s Solver) Part2(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part2()
+}
+
+// Rotations is a parsed puzzle input.
+type Rotations []Rotation
+
+// Dial applies every rotation to a fresh dial.
+func (rs Rotations) Dial() Dial {
+	dial := NewDial(dialSize, dialStart)
+	for _, r := range rs {
+		dial = r.Apply(dial)
+	}
+	return dial
+}
+
+// Part1 counts the rotations that leave the dial at zero.
+func (rs Rotations) Part1() (string, error) {
+	return strconv.Itoa(rs.Dial().Landings()), nil
+}
+
+// Part2 counts every click that passes the dial over zero.
+func (rs Rotations) Part2() (string, error) {
+	return strconv.Itoa(rs.Dial().Passes()), nil
+}
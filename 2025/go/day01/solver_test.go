@@ -0,0 +1,45 @@
+package day01
+
+import "testing"
+
+func TestSolver(t *testing.T) {
+	input := []string{
+		"L68",
+		"L30",
+		"R48",
+		"L5",
+		"R60",
+		"L55",
+		"L1",
+		"L99",
+		"R14",
+		"L82",
+	}
+
+	tests := []struct {
+		name     string
+		solve    func([]string) (string, error)
+		expected string
+	}{
+		{"Part 1", Solver{}.Part1, "3"},
+		{"Part 2", Solver{}.Part2, "6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, err := tt.solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if answer != tt.expected {
+				t.Errorf("answer = %s; want %s", answer, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSolverRejectsMalformedInput(t *testing.T) {
+	if _, err := (Solver{}).Part1([]string{"L68", "68"}); err == nil {
+		t.Error("Part1() accepted a rotation without a direction")
+	}
+}
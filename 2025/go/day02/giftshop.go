@@ -1,14 +1,10 @@
 package day02
 
-import (
-	"fmt"
-	"strconv"
-)
+import "strconv"
 
 type ProductID string
 
 func (p ProductID) IsInvalid() bool {
-	fmt.Println("Checking ProductID:", p)
 	if len(p)%2 != 0 {
 		return false
 	}
@@ -0,0 +1,115 @@
+package day02
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/manning0218/adventOfCode/go/parse"
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func init() {
+	solver.Register(2025, 2, Solver{})
+}
+
+// Solver sums the invalid product IDs in the input ranges.
+type Solver struct {
+	// Logger receives trace output; nil discards it.
+	Logger *slog.Logger
+}
+
+// WithLogger implements solver.Logged.
+func (s Solver) WithLogger(l *slog.Logger) solver.Solver {
+	s.Logger = l
+	return s
+}
+
+// Ranges is the parsed list of first-last ID ranges.
+type Ranges [][2]ProductID
+
+// loggedRanges is Ranges tracing each ID it checks.
+type loggedRanges struct {
+	Ranges
+	log *slog.Logger
+}
+
+// Parse implements solver.Parser.
+func (s Solver) Parse(input []string) (solver.Parsed, error) {
+	ranges, err := ParseRanges(input)
+	if err != nil {
+		return nil, err
+	}
+	return loggedRanges{ranges, s.Logger}, nil
+}
+
+// Part1 sums IDs made of a sequence repeated twice.
+func (s Solver) Part1(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part1()
+}
+
+// Part2 sums IDs made of a sequence repeated at least twice.
+func (s Solver) Part2(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part2()
+}
+
+// Part1 sums IDs made of a sequence repeated twice.
+func (r Ranges) Part1() (string, error) {
+	return loggedRanges{Ranges: r}.Part1()
+}
+
+// Part2 sums IDs made of a sequence repeated at least twice.
+func (r Ranges) Part2() (string, error) {
+	return loggedRanges{Ranges: r}.Part2()
+}
+
+func (r loggedRanges) Part1() (string, error) {
+	return r.sumInvalid(func(pid ProductID, _ int) bool { return pid.IsInvalid() }), nil
+}
+
+func (r loggedRanges) Part2() (string, error) {
+	return r.sumInvalid(ProductID.IsInvalid2), nil
+}
+
+func (r loggedRanges) sumInvalid(invalid func(ProductID, int) bool) string {
+	invalidIDSum := 0
+	for _, idRange := range r.Ranges {
+		for id := idRange[0].Value(); id <= idRange[1].Value(); id++ {
+			pid := ProductID(strconv.Itoa(id))
+			if solver.Tracing(r.log) {
+				solver.Trace(r.log, "checking product ID", "id", id)
+			}
+			if invalid(pid, id) {
+				invalidIDSum += pid.Value()
+			}
+		}
+	}
+	return strconv.Itoa(invalidIDSum)
+}
+
+// ParseRanges parses the comma-separated first-last ID ranges on the first line.
+func ParseRanges(input []string) (Ranges, error) {
+	if len(input) == 0 {
+		return nil, fmt.Errorf("input is empty")
+	}
+
+	var ranges Ranges
+	for _, field := range strings.Split(input[0], ",") {
+		first, last, err := parse.Range(field)
+		if err != nil {
+			return nil, &parse.Error{Line: 1, Err: err}
+		}
+		ranges = append(ranges, [2]ProductID{ProductID(strconv.Itoa(first)), ProductID(strconv.Itoa(last))})
+	}
+
+	return ranges, nil
+}
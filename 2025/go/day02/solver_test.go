@@ -0,0 +1,30 @@
+package day02
+
+import "testing"
+
+func TestSolver(t *testing.T) {
+	input := []string{
+		"11-22,95-115,998-1012,1188511880-1188511890,222220-222224,1698522-1698528,446443-446449,38593856-38593862,565653-565659,824824821-824824827,2121212118-2121212124",
+	}
+
+	tests := []struct {
+		name     string
+		solve    func([]string) (string, error)
+		expected string
+	}{
+		{"Part 1", Solver{}.Part1, "1227775554"},
+		{"Part 2", Solver{}.Part2, "4174379265"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, err := tt.solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if answer != tt.expected {
+				t.Errorf("answer = %s; want %s", answer, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package day03
+
+import (
+	"strconv"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func init() {
+	solver.Register(2025, 3, Solver{})
+}
+
+// Solver sums the largest joltage of every bank.
+type Solver struct{}
+
+// Part1 keeps two cells per bank.
+func (Solver) Part1(input []string) (string, error) {
+	return totalJoltage(input, 2), nil
+}
+
+// Part2 keeps twelve cells per bank.
+func (Solver) Part2(input []string) (string, error) {
+	return totalJoltage(input, 12), nil
+}
+
+func totalJoltage(input []string, cellsToKeep int) string {
+	joltage := 0
+	for _, bank := range input {
+		joltage += int(Bank(bank).LargestJoltage(cellsToKeep))
+	}
+	return strconv.Itoa(joltage)
+}
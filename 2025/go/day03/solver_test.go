@@ -0,0 +1,33 @@
+package day03
+
+import "testing"
+
+func TestSolver(t *testing.T) {
+	input := []string{
+		"987654321111111",
+		"811111111111119",
+		"234234234234278",
+		"818181911112111",
+	}
+
+	tests := []struct {
+		name     string
+		solve    func([]string) (string, error)
+		expected string
+	}{
+		{"Part 1", Solver{}.Part1, "357"},
+		{"Part 2", Solver{}.Part2, "3121910778619"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, err := tt.solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if answer != tt.expected {
+				t.Errorf("answer = %s; want %s", answer, tt.expected)
+			}
+		})
+	}
+}
@@ -1,45 +1,39 @@
 package day04
 
-type GridPrintDept [][]rune
-type RollLocations [][2]int
+import (
+	"github.com/manning0218/adventOfCode/go/grid"
+)
 
-var directions = [][2]int{
-	{-1, -1}, {-1, 0}, {-1, 1}, // northwest, north, northeast
-	{0, -1}, {0, 1}, // west,        , east
-	{1, -1}, {1, 0}, {1, 1}, // southwest, south, southeast
+// GridPrintDept is the printing department floor plan; '@' marks a paper roll.
+type GridPrintDept struct {
+	grid.Grid[rune]
 }
 
-func NewGridPrintDept(lines []string) GridPrintDept {
-	height := len(lines)
-	if height == 0 {
-		return GridPrintDept{}
+type RollLocations []grid.Point
+
+// ParseGridPrintDept reads the floor plan, rejecting ragged rows.
+func ParseGridPrintDept(lines []string) (GridPrintDept, error) {
+	g, err := grid.Parse(lines)
+	if err != nil {
+		return GridPrintDept{}, err
 	}
-	width := len(lines[0])
-	dept := make(GridPrintDept, height)
-	for i := 0; i < height; i++ {
-		dept[i] = make([]rune, width)
-		for j, ch := range lines[i] {
-			dept[i][j] = ch
-		}
+	return GridPrintDept{g}, nil
+}
+
+// NewGridPrintDept is like ParseGridPrintDept but panics on malformed input.
+func NewGridPrintDept(lines []string) GridPrintDept {
+	dept, err := ParseGridPrintDept(lines)
+	if err != nil {
+		panic(err)
 	}
 	return dept
 }
 
 func (g GridPrintDept) NumberOfNeighbors(row, col int, target rune) int {
 	count := 0
-	height := len(g)
-	if height == 0 {
-		return 0
-	}
-	width := len(g[0])
-
-	for _, dir := range directions {
-		newRow := row + dir[0]
-		newCol := col + dir[1]
-		if newRow >= 0 && newRow < height && newCol >= 0 && newCol < width {
-			if g[newRow][newCol] == target {
-				count++
-			}
+	for p := range g.Neighbors8(grid.Point{Row: row, Col: col}) {
+		if g.At(p) == target {
+			count++
 		}
 	}
 	return count
@@ -47,31 +41,21 @@ func (g GridPrintDept) NumberOfNeighbors(row, col int, target rune) int {
 
 func (g GridPrintDept) FindNumberPaperToMove(maxNeighbors int) RollLocations {
 	locations := RollLocations{}
-	height := len(g)
-	if height == 0 {
-		return nil
-	}
-	width := len(g[0])
-
-	for i := 0; i < height; i++ {
-		for j := 0; j < width; j++ {
-			if g[i][j] != '@' {
-				continue
-			}
-			neighborCount := g.NumberOfNeighbors(i, j, '@')
-			if neighborCount < maxNeighbors {
-				locations = append(locations, [2]int{i, j})
-			}
+	for p, cell := range g.All() {
+		if cell != '@' {
+			continue
+		}
+		if g.NumberOfNeighbors(p.Row, p.Col, '@') < maxNeighbors {
+			locations = append(locations, p)
 		}
 	}
 	return locations
 }
 
 func (g GridPrintDept) RemoveRollLocations(locations RollLocations) {
-	for _, loc := range locations {
-		row, col := loc[0], loc[1]
-		if g[row][col] == '@' {
-			g[row][col] = '.'
+	for _, p := range locations {
+		if g.At(p) == '@' {
+			g.Set(p, '.')
 		}
 	}
 }
@@ -0,0 +1,53 @@
+package day04
+
+import (
+	"strconv"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func init() {
+	solver.Register(2025, 4, Solver{})
+}
+
+// Solver counts the paper rolls a forklift can reach.
+type Solver struct{}
+
+// Parse implements solver.Parser.
+func (Solver) Parse(input []string) (solver.Parsed, error) {
+	return ParseGridPrintDept(input)
+}
+
+// Part1 counts the rolls with fewer than four neighbours.
+func (Solver) Part1(input []string) (string, error) {
+	dept, err := ParseGridPrintDept(input)
+	if err != nil {
+		return "", err
+	}
+	return dept.Part1()
+}
+
+// Part2 keeps removing accessible rolls until none are left and counts them.
+func (Solver) Part2(input []string) (string, error) {
+	dept, err := ParseGridPrintDept(input)
+	if err != nil {
+		return "", err
+	}
+	return dept.Part2()
+}
+
+// Part1 counts the rolls with fewer than four neighbours.
+func (g GridPrintDept) Part1() (string, error) {
+	return strconv.Itoa(len(g.FindNumberPaperToMove(4))), nil
+}
+
+// Part2 keeps removing accessible rolls until none are left and counts them.
+// It modifies the grid.
+func (g GridPrintDept) Part2() (string, error) {
+	removed := 0
+	for pos := g.FindNumberPaperToMove(4); len(pos) > 0; pos = g.FindNumberPaperToMove(4) {
+		removed += len(pos)
+		g.RemoveRollLocations(pos)
+	}
+	return strconv.Itoa(removed), nil
+}
@@ -0,0 +1,39 @@
+package day04
+
+import "testing"
+
+func TestSolver(t *testing.T) {
+	input := []string{
+		"..@@.@@@@.",
+		"@@@.@.@.@@",
+		"@@@@@.@.@@",
+		"@.@@@@..@.",
+		"@@.@@@@.@@",
+		".@@@@@@@.@",
+		".@.@.@.@@@",
+		"@.@@@.@@@@",
+		".@@@@@@@@.",
+		"@.@.@@@.@.",
+	}
+
+	tests := []struct {
+		name     string
+		solve    func([]string) (string, error)
+		expected string
+	}{
+		{"Part 1", Solver{}.Part1, "13"},
+		{"Part 2", Solver{}.Part2, "43"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, err := tt.solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if answer != tt.expected {
+				t.Errorf("answer = %s; want %s", answer, tt.expected)
+			}
+		})
+	}
+}
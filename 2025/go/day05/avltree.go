@@ -1,10 +1,6 @@
 package day05
 
-import (
-	"fmt"
-	"strconv"
-	"strings"
-)
+import "github.com/manning0218/adventOfCode/go/parse"
 
 // Interval represents a range [Start, End] inclusive
 type Interval struct {
@@ -217,26 +213,11 @@ func (t *AVLIntervalTree) search(node *IntervalNode, value int64) bool {
 
 // ParseInterval parses a string like "123-456" into an Interval
 func ParseInterval(s string) (Interval, error) {
-	parts := strings.Split(s, "-")
-	if len(parts) != 2 {
-		return Interval{}, fmt.Errorf("invalid interval format: %s", s)
-	}
-
-	start, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
-		return Interval{}, fmt.Errorf("invalid start value: %s", parts[0])
-	}
-
-	end, err := strconv.ParseInt(parts[1], 10, 64)
+	start, end, err := parse.Range(s)
 	if err != nil {
-		return Interval{}, fmt.Errorf("invalid end value: %s", parts[1])
-	}
-
-	if start > end {
-		return Interval{}, fmt.Errorf("start must be <= end: %d > %d", start, end)
+		return Interval{}, err
 	}
-
-	return Interval{Start: start, End: end}, nil
+	return Interval{Start: int64(start), End: int64(end)}, nil
 }
 
 // NewAVLIntervalTreeFromStrings creates a tree from a slice of interval strings
@@ -2,7 +2,10 @@ package day05
 
 import (
 	"fmt"
-	"strconv"
+	"log/slog"
+
+	"github.com/manning0218/adventOfCode/go/parse"
+	"github.com/manning0218/adventOfCode/go/solver"
 )
 
 type FreshIngredients struct {
@@ -11,41 +14,49 @@ type FreshIngredients struct {
 
 type AvailableIngredients []int64
 
+// NewIngredients parses the block of fresh ingredient ranges and the
+// block of available ingredients that follows it.
 func NewIngredients(lines []string) (*FreshIngredients, AvailableIngredients, error) {
-	tree := NewAVLIntervalTree()
-	availableStart := 0
-
-	// Parse fresh ingredient ranges and build AVL tree
-	for i, line := range lines {
-		if line == "" {
-			availableStart = i + 1
-			break
-		}
+	return newIngredients(lines, nil)
+}
 
-		fmt.Println("Processing line for fresh ingredients:", line)
+// newIngredients is NewIngredients tracing each line it parses to log.
+func newIngredients(lines []string, log *slog.Logger) (*FreshIngredients, AvailableIngredients, error) {
+	blocks := parse.Blocks(lines)
+	if len(blocks) == 0 {
+		return &FreshIngredients{tree: NewAVLIntervalTree()}, AvailableIngredients{}, nil
+	}
 
-		// Parse the interval using the AVL tree's ParseInterval function
-		interval, err := ParseInterval(line)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to parse interval '%s': %w", line, err)
+	// Parse fresh ingredient ranges and build AVL tree
+	intervals, err := parse.LinesAt(blocks[0].Line, blocks[0].Lines, func(line string) (Interval, error) {
+		if solver.Tracing(log) {
+			solver.Trace(log, "parsing fresh ingredient range", "line", line)
 		}
+		return ParseInterval(line)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse fresh ingredients: %w", err)
+	}
+	tree := NewAVLIntervalTree()
+	for _, interval := range intervals {
 		tree.Insert(interval)
 	}
-
 	freshIngredients := &FreshIngredients{tree: tree}
 
 	// Parse available ingredients
 	availableIngredients := make(AvailableIngredients, 0)
-	for _, line := range lines[availableStart:] {
-		if line == "" {
-			continue
-		}
-		fmt.Println("Processing line for available ingredients:", line)
-		ingredient, err := strconv.ParseInt(line, 10, 64)
+	for _, block := range blocks[1:] {
+		ingredients, err := parse.LinesAt(block.Line, block.Lines, func(line string) (int64, error) {
+			if solver.Tracing(log) {
+				solver.Trace(log, "parsing available ingredient", "line", line)
+			}
+			ingredient, err := parse.Int(line)
+			return int64(ingredient), err
+		})
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to parse ingredient '%s': %w", line, err)
+			return nil, nil, fmt.Errorf("failed to parse available ingredients: %w", err)
 		}
-		availableIngredients = append(availableIngredients, ingredient)
+		availableIngredients = append(availableIngredients, ingredients...)
 	}
 
 	return freshIngredients, availableIngredients, nil
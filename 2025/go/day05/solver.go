@@ -0,0 +1,68 @@
+package day05
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func init() {
+	solver.Register(2025, 5, Solver{})
+}
+
+// Solver counts fresh ingredients.
+type Solver struct {
+	// Logger receives trace output; nil discards it.
+	Logger *slog.Logger
+}
+
+// WithLogger implements solver.Logged.
+func (s Solver) WithLogger(l *slog.Logger) solver.Solver {
+	s.Logger = l
+	return s
+}
+
+// Inventory is the parsed database of fresh ranges and available ingredients.
+type Inventory struct {
+	Fresh     *FreshIngredients
+	Available AvailableIngredients
+}
+
+// Parse implements solver.Parser.
+func (s Solver) Parse(input []string) (solver.Parsed, error) {
+	freshIngredients, availableIngredients, err := newIngredients(input, s.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ingredients: %w", err)
+	}
+	return Inventory{Fresh: freshIngredients, Available: availableIngredients}, nil
+}
+
+// Part1 counts the available ingredients that are fresh.
+func (s Solver) Part1(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part1()
+}
+
+// Part2 counts every ingredient ID the fresh ranges cover.
+func (s Solver) Part2(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part2()
+}
+
+// Part1 counts the available ingredients that are fresh.
+func (inv Inventory) Part1() (string, error) {
+	return strconv.Itoa(inv.Available.CountFreshIngredientsAvailable(inv.Fresh)), nil
+}
+
+// Part2 counts every ingredient ID the fresh ranges cover.
+func (inv Inventory) Part2() (string, error) {
+	return strconv.FormatInt(inv.Fresh.CountTotalFreshIngredients(), 10), nil
+}
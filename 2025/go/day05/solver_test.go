@@ -0,0 +1,40 @@
+package day05
+
+import "testing"
+
+func TestSolver(t *testing.T) {
+	input := []string{
+		"3-5",
+		"10-14",
+		"16-20",
+		"12-18",
+		"",
+		"1",
+		"5",
+		"8",
+		"11",
+		"17",
+		"32",
+	}
+
+	tests := []struct {
+		name     string
+		solve    func([]string) (string, error)
+		expected string
+	}{
+		{"Part 1", Solver{}.Part1, "3"},
+		{"Part 2", Solver{}.Part2, "14"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, err := tt.solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if answer != tt.expected {
+				t.Errorf("answer = %s; want %s", answer, tt.expected)
+			}
+		})
+	}
+}
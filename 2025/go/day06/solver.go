@@ -0,0 +1,51 @@
+package day06
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func init() {
+	solver.Register(2025, 6, Solver{})
+}
+
+// Solver totals the cephalopod math worksheet.
+type Solver struct {
+	// Logger receives debug output; nil discards it.
+	Logger *slog.Logger
+}
+
+// WithLogger implements solver.Logged.
+func (s Solver) WithLogger(l *slog.Logger) solver.Solver {
+	s.Logger = l
+	return s
+}
+
+// Part1 reads the numbers row by row.
+func (s Solver) Part1(input []string) (string, error) {
+	columns, err := parseColumns(input, s.Logger)
+	if err != nil {
+		return "", fmt.Errorf("error parsing columns: %w", err)
+	}
+	return grandTotal(columns.ComputeResults()), nil
+}
+
+// Part2 reads the numbers column by column, right to left.
+func (Solver) Part2(input []string) (string, error) {
+	columns, err := ParseCephalopod(input)
+	if err != nil {
+		return "", fmt.Errorf("error parsing cephalopod columns: %w", err)
+	}
+	return grandTotal(columns.ComputeResults()), nil
+}
+
+func grandTotal(results []int64) string {
+	var total int64
+	for _, result := range results {
+		total += result
+	}
+	return strconv.FormatInt(total, 10)
+}
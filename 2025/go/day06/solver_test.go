@@ -0,0 +1,33 @@
+package day06
+
+import "testing"
+
+func TestSolver(t *testing.T) {
+	input := []string{
+		"123 328  51 64 ",
+		" 45 64  387 23 ",
+		"  6 98  215 314",
+		"*   +   *   +  ",
+	}
+
+	tests := []struct {
+		name     string
+		solve    func([]string) (string, error)
+		expected string
+	}{
+		{"Part 1", Solver{}.Part1, "4277556"},
+		{"Part 2", Solver{}.Part2, "3263827"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, err := tt.solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if answer != tt.expected {
+				t.Errorf("answer = %s; want %s", answer, tt.expected)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package day06
 
 import (
 	"fmt"
+	"log/slog"
 	"strings"
+
+	"github.com/manning0218/adventOfCode/go/parse"
 )
 
 type Column struct {
@@ -13,6 +16,11 @@ type Column struct {
 type Columns []Column
 
 func ParseColumns(lines []string) (Columns, error) {
+	return parseColumns(lines, nil)
+}
+
+// parseColumns is ParseColumns logging the parsed columns to log.
+func parseColumns(lines []string, log *slog.Logger) (Columns, error) {
 	if len(lines) == 0 {
 		return nil, nil
 	}
@@ -25,22 +33,26 @@ func ParseColumns(lines []string) (Columns, error) {
 		columns[i].Operation = rune(operation[0])
 	}
 
-	for row, line := range lines[:len(lines)-1] {
-		// Parsing logic for each line to create Column instances
-		data := strings.Fields(line)
-
-		for col, str := range data {
-			var value int64
-			_, err := fmt.Sscanf(str, "%d", &value)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse integer '%s': %w", str, err)
-			}
-			columns[col].Data[row] = value
+	rows, err := parse.Lines(lines[:len(lines)-1], func(line string) ([]int, error) {
+		values, err := parse.Ints(line)
+		if err == nil && len(values) > len(columns) {
+			err = fmt.Errorf("%d values for %d operations", len(values), len(columns))
+		}
+		return values, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse columns: %w", err)
+	}
 
+	for row, values := range rows {
+		for col, value := range values {
+			columns[col].Data[row] = int64(value)
 		}
 	}
 
-	fmt.Println("Parsed columns:", columns)
+	if log != nil {
+		log.Debug("parsed columns", "columns", columns)
+	}
 
 	return columns, nil
 }
@@ -0,0 +1,74 @@
+package day07
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func init() {
+	solver.Register(2025, 7, Solver{})
+}
+
+// Solver traces tachyon beams through the manifold diagram.
+type Solver struct {
+	// Logger receives trace output; nil discards it.
+	Logger *slog.Logger
+}
+
+// WithLogger implements solver.Logged.
+func (s Solver) WithLogger(l *slog.Logger) solver.Solver {
+	s.Logger = l
+	return s
+}
+
+// Manifold is a parsed diagram together with its start point.
+type Manifold struct {
+	Diagram Diagram
+	Start   Point
+
+	log *slog.Logger
+}
+
+// Parse implements solver.Parser.
+func (s Solver) Parse(input []string) (solver.Parsed, error) {
+	diagram, err := ParseDiagram(input)
+	if err != nil {
+		return nil, err
+	}
+	start, err := diagram.FindStart()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find start point: %w", err)
+	}
+	return Manifold{Diagram: diagram, Start: start, log: s.Logger}, nil
+}
+
+// Part1 counts how often the beam is split.
+func (s Solver) Part1(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part1()
+}
+
+// Part2 counts the distinct timelines a single particle can take.
+func (s Solver) Part2(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part2()
+}
+
+// Part1 counts how often the beam is split.
+func (m Manifold) Part1() (string, error) {
+	return strconv.Itoa(m.Diagram.shootBeam(m.Start, m.log)), nil
+}
+
+// Part2 counts the distinct timelines a single particle can take.
+func (m Manifold) Part2() (string, error) {
+	return strconv.Itoa(NewBeamPathFinder(m.Diagram).CountPaths(m.Start)), nil
+}
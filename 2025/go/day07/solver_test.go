@@ -0,0 +1,32 @@
+package day07
+
+import (
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/utils"
+)
+
+func TestSolver(t *testing.T) {
+	input := utils.ReadExample(7, 1)
+
+	tests := []struct {
+		name     string
+		solve    func([]string) (string, error)
+		expected string
+	}{
+		{"Part 1", Solver{}.Part1, "21"},
+		{"Part 2", Solver{}.Part2, "40"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, err := tt.solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if answer != tt.expected {
+				t.Errorf("answer = %s; want %s", answer, tt.expected)
+			}
+		})
+	}
+}
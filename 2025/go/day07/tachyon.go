@@ -1,23 +1,48 @@
 package day07
 
 import (
+	"cmp"
 	"errors"
-	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/manning0218/adventOfCode/go/graph"
+	"github.com/manning0218/adventOfCode/go/grid"
+	"github.com/manning0218/adventOfCode/go/solver"
 )
 
 var ErrStartNotFound = errors.New("start point 'S' not found in diagram")
 
-type Diagram [][]rune
+// Diagram is the manifold: 'S' is the start, '^' a splitter and '.' empty.
+type Diagram struct {
+	grid.Grid[rune]
+}
+
+// ParseDiagram reads a diagram, rejecting ragged rows.
+func ParseDiagram(lines []string) (Diagram, error) {
+	g, err := grid.Parse(lines)
+	if err != nil {
+		return Diagram{}, err
+	}
+	return Diagram{g}, nil
+}
 
+// NewDiagram is like ParseDiagram but panics on malformed input.
 func NewDiagram(lines []string) Diagram {
-	diagram := make(Diagram, len(lines))
-	for i, line := range lines {
-		diagram[i] = []rune(line)
+	d, err := ParseDiagram(lines)
+	if err != nil {
+		panic(err)
 	}
-	return diagram
+	return d
 }
 
+// Point is a diagram position; X is the row and Y the column.
 type Point struct{ X, Y int }
+
+// GridPoint converts p to the grid package's coordinates.
+func (p Point) GridPoint() grid.Point { return grid.Point{Row: p.X, Col: p.Y} }
+
 type Path []Point
 
 type Direction int
@@ -29,50 +54,62 @@ const (
 )
 
 func (d Diagram) FindStart() (Point, error) {
-	for x, row := range d {
-		for y, data := range row {
-			if data == 'S' {
-				return Point{X: x, Y: y}, nil
-			}
+	p, ok := grid.Find(d.Grid, 'S')
+	if !ok {
+		return Point{}, ErrStartNotFound
+	}
+	return Point{X: p.Row, Y: p.Col}, nil
+}
+
+// Neighbors implements graph.Graph: a beam moves down through empty cells
+// and a splitter sends it left and right. Beams leaving the diagram end.
+func (d Diagram) Neighbors(p Point) []graph.Edge[Point] {
+	if !d.IsInBounds(p) {
+		return nil
+	}
+	next := []Point{{X: p.X + 1, Y: p.Y}}
+	if d.At(p.GridPoint()) == '^' {
+		next = []Point{{X: p.X, Y: p.Y - 1}, {X: p.X, Y: p.Y + 1}}
+	}
+	edges := make([]graph.Edge[Point], 0, len(next))
+	for _, q := range next {
+		if d.IsInBounds(q) {
+			edges = append(edges, graph.Edge[Point]{To: q, Cost: 1})
 		}
 	}
-	return Point{}, ErrStartNotFound
+	return edges
 }
 
+// ShootBeam counts the splitters a beam fired from start reaches.
 func (d Diagram) ShootBeam(start Point) int {
-	splitCount := 0
-	visited := map[Point]bool{}
-	visit := []Point{start}
-
-	// Use depth-first search to explore the diagram
-	for len(visit) > 0 {
-		current := visit[len(visit)-1]
-		visit = visit[:len(visit)-1]
-
-		if visited[current] {
-			continue
-		}
-		visited[current] = true
+	return d.shootBeam(start, nil)
+}
 
-		// Check if the current position is within bounds
-		if current.X < 0 || current.X >= len(d) || current.Y < 0 || current.Y >= len(d[0]) {
-			continue
+// shootBeam is ShootBeam tracing each point the beam reaches, and each
+// split, to log in row order.
+func (d Diagram) shootBeam(start Point, log *slog.Logger) int {
+	if !d.IsInBounds(start) {
+		return 0
+	}
+	reached := graph.DFS[Point](d, []Point{start}, nil).Dist
+	if solver.Tracing(log) {
+		points := slices.SortedFunc(maps.Keys(reached), func(p, q Point) int {
+			return cmp.Or(cmp.Compare(p.X, q.X), cmp.Compare(p.Y, q.Y))
+		})
+		for _, p := range points {
+			solver.Trace(log, "visiting", "point", p)
+			if d.At(p.GridPoint()) == '^' {
+				solver.Trace(log, "split", "point", p)
+			}
 		}
+	}
 
-		fmt.Println("Visiting:", current)
-
-		cell := d[current.X][current.Y]
-		if cell == '^' {
-			fmt.Println("Split at:", current)
+	splitCount := 0
+	for p := range reached {
+		if d.At(p.GridPoint()) == '^' {
 			splitCount++
-			visit = append(visit, Point{X: current.X, Y: current.Y - 1})
-			visit = append(visit, Point{X: current.X, Y: current.Y + 1})
-			continue
 		}
-
-		visit = append(visit, Point{X: current.X + 1, Y: current.Y})
 	}
-
 	return splitCount
 }
 
@@ -97,26 +134,26 @@ func NewBeamPathFinder(diagram Diagram) *BeamPathFinder {
 }
 
 func (d Diagram) IsInBounds(p Point) bool {
-	return p.X >= 0 && p.X < len(d) && p.Y >= 0 && p.Y < len(d[0])
+	return d.InBounds(p.GridPoint())
 }
 
 func (bpf *BeamPathFinder) FindAllPaths(start Point) []Path {
 	// Pre-allocate based on grid size estimate
-	estimatedPaths := (len(bpf.Diagram) * len(bpf.Diagram[0])) / 10
+	estimatedPaths := (bpf.Diagram.Height() * bpf.Diagram.Width()) / 10
 	if estimatedPaths < 32 {
 		estimatedPaths = 32
 	}
 	bpf.Paths = make([]Path, 0, estimatedPaths)
 
 	// Pre-allocate stack with reasonable capacity
-	estimatedStackSize := len(bpf.Diagram) * 2
+	estimatedStackSize := bpf.Diagram.Height() * 2
 	if estimatedStackSize < 64 {
 		estimatedStackSize = 64
 	}
 	stack := make([]BeamWork, 0, estimatedStackSize)
 
 	// Initial beam with pre-allocated path
-	initialPath := make([]Point, 1, len(bpf.Diagram)*2)
+	initialPath := make([]Point, 1, bpf.Diagram.Height()*2)
 	initialPath[0] = start
 	stack = append(stack, BeamWork{
 		Pos:         start,
@@ -144,7 +181,7 @@ func (bpf *BeamPathFinder) FindAllPaths(start Point) []Path {
 		// This avoids a full copy + allocation
 		newPath := append(beam.CurrentPath[:len(beam.CurrentPath):len(beam.CurrentPath)], nextPos)
 
-		cell := bpf.Diagram[nextPos.X][nextPos.Y]
+		cell := bpf.Diagram.At(nextPos.GridPoint())
 
 		switch cell {
 		case '.', 'S':
@@ -225,7 +262,7 @@ func (bpf *BeamPathFinder) CountPaths(start Point) int {
 	totalPaths := 0
 
 	// Process row by row from start
-	for row := start.X; row < len(bpf.Diagram); row++ {
+	for row := start.X; row < bpf.Diagram.Height(); row++ {
 		nextBeams := make(map[int]int)
 
 		for col, beamCount := range currentBeams {
@@ -233,7 +270,7 @@ func (bpf *BeamPathFinder) CountPaths(start Point) int {
 			nextRow := row + 1
 
 			// Check if beams exit the grid
-			if nextRow >= len(bpf.Diagram) {
+			if nextRow >= bpf.Diagram.Height() {
 				totalPaths += beamCount
 				continue
 			}
@@ -242,12 +279,12 @@ func (bpf *BeamPathFinder) CountPaths(start Point) int {
 			nextCol := col
 
 			// First check bounds
-			if nextCol < 0 || nextCol >= len(bpf.Diagram[0]) {
+			if nextCol < 0 || nextCol >= bpf.Diagram.Width() {
 				totalPaths += beamCount
 				continue
 			}
 
-			cell := bpf.Diagram[nextRow][nextCol]
+			cell := bpf.Diagram.At(grid.Point{Row: nextRow, Col: nextCol})
 
 			switch cell {
 			case '.', 'S':
@@ -260,7 +297,7 @@ func (bpf *BeamPathFinder) CountPaths(start Point) int {
 				rightCol := nextCol + 1
 
 				// Left beam
-				if leftCol >= 0 && leftCol < len(bpf.Diagram[0]) {
+				if leftCol >= 0 && leftCol < bpf.Diagram.Width() {
 					// Continue down from left position
 					nextBeams[leftCol] += beamCount
 				} else {
@@ -269,7 +306,7 @@ func (bpf *BeamPathFinder) CountPaths(start Point) int {
 				}
 
 				// Right beam
-				if rightCol >= 0 && rightCol < len(bpf.Diagram[0]) {
+				if rightCol >= 0 && rightCol < bpf.Diagram.Width() {
 					// Continue down from right position
 					nextBeams[rightCol] += beamCount
 				} else {
@@ -115,7 +115,7 @@ func BenchmarkCountPathsLarge(b *testing.B) {
 		"...............",
 		".....^.^.^.....",
 		"...............",
-		"....^.^...^.....",
+		"....^.^...^....",
 		"...............",
 		"...^.^...^.^...",
 		"...............",
@@ -1,28 +1,19 @@
 package day07
 
 import (
+	"bytes"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+	"github.com/manning0218/adventOfCode/go/utils"
 )
 
 func TestFindStart(t *testing.T) {
-	lines := []string{
-		".......S.......",
-		"...............",
-		".......^.......",
-		"...............",
-		"......^.^......",
-		"...............",
-		".....^.^.^.....",
-		"...............",
-		"....^.^...^.....",
-		"...............",
-		"...^.^...^.^...",
-		"...............",
-		"..^...^.....^..",
-		"...............",
-		".^.^.^.^.^...^.",
-		"...............",
-	}
+	lines := utils.ReadExample(7, 1)
 
 	diagram := NewDiagram(lines)
 
@@ -38,24 +29,7 @@ func TestFindStart(t *testing.T) {
 }
 
 func TestShootBeam(t *testing.T) {
-	lines := []string{
-		".......S.......",
-		"...............",
-		".......^.......",
-		"...............",
-		"......^.^......",
-		"...............",
-		".....^.^.^.....",
-		"...............",
-		"....^.^...^.....",
-		"...............",
-		"...^.^...^.^...",
-		"...............",
-		"..^...^.....^..",
-		"...............",
-		".^.^.^.^.^...^.",
-		"...............",
-	}
+	lines := utils.ReadExample(7, 1)
 
 	diagram := NewDiagram(lines)
 
@@ -71,25 +45,50 @@ func TestShootBeam(t *testing.T) {
 	}
 }
 
-func TestFindPaths(t *testing.T) {
-	lines := []string{
-		".......S.......",
-		"...............",
-		".......^.......",
-		"...............",
-		"......^.^......",
-		"...............",
-		".....^.^.^.....",
-		"...............",
-		"....^.^...^.....",
-		"...............",
-		"...^.^...^.^...",
-		"...............",
-		"..^...^.....^..",
-		"...............",
-		".^.^.^.^.^...^.",
-		"...............",
+func TestShootBeamTrace(t *testing.T) {
+	var buf bytes.Buffer
+	input := utils.ReadExample(7, 1)
+	// Drop the timestamps so that two runs can be compared.
+	noTime := func(_ []string, attr slog.Attr) slog.Attr {
+		if attr.Key == slog.TimeKey {
+			return slog.Attr{}
+		}
+		return attr
+	}
+	s := Solver{Logger: slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: solver.LevelTrace, ReplaceAttr: noTime}))}
+	answer, err := s.Part1(input)
+	if err != nil {
+		t.Fatalf("Part1() error = %v", err)
+	}
+
+	if got := strings.Count(buf.String(), "msg=split"); strconv.Itoa(got) != answer {
+		t.Errorf("expected %s split trace lines, got %d", answer, got)
+	}
+	if !strings.Contains(buf.String(), "msg=visiting") {
+		t.Errorf("expected visiting trace lines, got:\n%s", buf.String())
+	}
+
+	first := buf.String()
+	buf.Reset()
+	if _, err := s.Part1(input); err != nil {
+		t.Fatalf("Part1() error = %v", err)
 	}
+	if buf.String() != first {
+		t.Errorf("trace differs between runs:\n%s\nthen:\n%s", first, buf.String())
+	}
+
+	buf.Reset()
+	s.Logger = slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	if _, err := s.Part1(input); err != nil {
+		t.Fatalf("Part1() error = %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no trace output at debug level, got:\n%s", buf.String())
+	}
+}
+
+func TestFindPaths(t *testing.T) {
+	lines := utils.ReadExample(7, 1)
 
 	diagram := NewDiagram(lines)
 	finder := NewBeamPathFinder(diagram)
@@ -108,24 +107,7 @@ func TestFindPaths(t *testing.T) {
 }
 
 func TestCountPaths(t *testing.T) {
-	lines := []string{
-		".......S.......",
-		"...............",
-		".......^.......",
-		"...............",
-		"......^.^......",
-		"...............",
-		".....^.^.^.....",
-		"...............",
-		"....^.^...^.....",
-		"...............",
-		"...^.^...^.^...",
-		"...............",
-		"..^...^.....^..",
-		"...............",
-		".^.^.^.^.^...^.",
-		"...............",
-	}
+	lines := utils.ReadExample(7, 1)
 
 	diagram := NewDiagram(lines)
 	finder := NewBeamPathFinder(diagram)
@@ -164,3 +146,29 @@ func TestCountPathsSimple(t *testing.T) {
 		t.Errorf("CountPaths() = %d; want %d", pathCount, expectedCount)
 	}
 }
+
+func TestDiagramNeighbors(t *testing.T) {
+	diagram := NewDiagram([]string{
+		"S.",
+		"^.",
+	})
+
+	tests := []struct {
+		p        Point
+		expected []Point
+	}{
+		{Point{X: 0, Y: 0}, []Point{{X: 1, Y: 0}}},
+		{Point{X: 1, Y: 0}, []Point{{X: 1, Y: 1}}},
+		{Point{X: 1, Y: 1}, nil},
+	}
+
+	for _, tt := range tests {
+		var got []Point
+		for _, e := range diagram.Neighbors(tt.p) {
+			got = append(got, e.To)
+		}
+		if !slices.Equal(got, tt.expected) {
+			t.Errorf("Neighbors(%v) = %v; want %v", tt.p, got, tt.expected)
+		}
+	}
+}
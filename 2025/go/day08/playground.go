@@ -2,6 +2,8 @@ package day08
 
 import (
 	"fmt"
+
+	"github.com/manning0218/adventOfCode/go/parse"
 )
 
 type JunctionBoxLocation struct {
@@ -12,17 +14,29 @@ type JunctionBoxLocation struct {
 
 type JunctionBoxes []JunctionBoxLocation
 
+// NewJunctionBoxes parses one X,Y,Z location per line.
+// It panics on any failure; use ParseJunctionBoxes to handle errors.
 func NewJunctionBoxes(input []string) JunctionBoxes {
-	boxes := make(JunctionBoxes, 0, len(input))
-	for _, line := range input {
-		var x, y, z int
-		_, err := fmt.Sscanf(line, "%d,%d,%d", &x, &y, &z)
+	boxes, err := ParseJunctionBoxes(input)
+	if err != nil {
+		panic(err.Error())
+	}
+	return boxes
+}
+
+// ParseJunctionBoxes parses one X,Y,Z location per line.
+func ParseJunctionBoxes(input []string) (JunctionBoxes, error) {
+	boxes, err := parse.Lines(input, func(line string) (JunctionBoxLocation, error) {
+		xyz, err := parse.Tuple(line, 3)
 		if err != nil {
-			panic(fmt.Sprintf("failed to parse junction box location from line %q: %v", line, err))
+			return JunctionBoxLocation{}, err
 		}
-		boxes = append(boxes, JunctionBoxLocation{X: x, Y: y, Z: z})
+		return JunctionBoxLocation{X: xyz[0], Y: xyz[1], Z: xyz[2]}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse junction box locations: %w", err)
 	}
-	return boxes
+	return boxes, nil
 }
 
 type Circuit []JunctionBoxLocation
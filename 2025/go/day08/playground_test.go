@@ -1,8 +1,11 @@
 package day08
 
 import (
+	"errors"
 	"math"
 	"testing"
+
+	"github.com/manning0218/adventOfCode/go/parse"
 )
 
 func TestDistanceTo(t *testing.T) {
@@ -208,6 +211,20 @@ func TestNewJunctionBoxes(t *testing.T) {
 	}
 }
 
+func TestParseJunctionBoxesError(t *testing.T) {
+	input := []string{
+		"1,2,3",
+		"10,20",
+		"-5,-10,-15",
+	}
+
+	_, err := ParseJunctionBoxes(input)
+	var parseErr *parse.Error
+	if !errors.As(err, &parseErr) || parseErr.Line != 2 {
+		t.Errorf("ParseJunctionBoxes() error = %v; want parse error on line 2", err)
+	}
+}
+
 func TestFindLastConnection(t *testing.T) {
 	// Create two clear clusters that are far apart
 	input := []string{
@@ -0,0 +1,82 @@
+package day08
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func init() {
+	solver.Register(2025, 8, Solver{Connections: 1000})
+}
+
+// Solver wires junction boxes into circuits.
+type Solver struct {
+	// Connections is how many of the shortest connections are made first.
+	Connections int
+}
+
+// Playground is the parsed set of junction boxes to wire.
+type Playground struct {
+	Boxes       JunctionBoxes
+	Connections int
+}
+
+// Parse implements solver.Parser.
+func (s Solver) Parse(input []string) (solver.Parsed, error) {
+	boxes, err := ParseJunctionBoxes(input)
+	if err != nil {
+		return nil, err
+	}
+	return Playground{Boxes: boxes, Connections: s.Connections}, nil
+}
+
+// Part1 multiplies the sizes of the three largest circuits.
+func (s Solver) Part1(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part1()
+}
+
+// Part2 multiplies the X coordinates of the boxes joined by the connection
+// that completes a single circuit.
+func (s Solver) Part2(input []string) (string, error) {
+	parsed, err := s.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Part2()
+}
+
+// Part1 multiplies the sizes of the three largest circuits.
+func (p Playground) Part1() (string, error) {
+	circuits := p.Boxes.BuildCircuits(p.Connections)
+	circuitSizes := make([]int, len(circuits))
+	for i, circuit := range circuits {
+		circuitSizes[i] = len(circuit)
+	}
+	sort.Ints(circuitSizes)
+	if len(circuitSizes) < 3 {
+		return "", fmt.Errorf("need at least 3 circuits, got %d", len(circuitSizes))
+	}
+
+	product := 1
+	for _, size := range circuitSizes[len(circuitSizes)-3:] {
+		product *= size
+	}
+	return strconv.Itoa(product), nil
+}
+
+// Part2 multiplies the X coordinates of the boxes joined by the connection
+// that completes a single circuit.
+func (p Playground) Part2() (string, error) {
+	lastConnection, err := p.Boxes.FindLastConnection(p.Connections)
+	if err != nil {
+		return "", fmt.Errorf("failed to find last connection: %w", err)
+	}
+	return strconv.Itoa(lastConnection.Box1.X * lastConnection.Box2.X), nil
+}
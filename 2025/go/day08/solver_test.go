@@ -0,0 +1,49 @@
+package day08
+
+import "testing"
+
+func TestSolver(t *testing.T) {
+	input := []string{
+		"162,817,812",
+		"57,618,57",
+		"906,360,560",
+		"592,479,940",
+		"352,342,300",
+		"466,668,158",
+		"542,29,236",
+		"431,825,988",
+		"739,650,466",
+		"52,470,668",
+		"216,146,977",
+		"819,987,18",
+		"117,168,530",
+		"805,96,715",
+		"346,949,466",
+		"970,615,88",
+		"941,993,340",
+		"862,61,35",
+		"984,92,344",
+		"425,690,689",
+	}
+
+	tests := []struct {
+		name     string
+		solve    func([]string) (string, error)
+		expected string
+	}{
+		{"Part 1", Solver{Connections: 10}.Part1, "40"},
+		{"Part 2", Solver{Connections: 10}.Part2, "25272"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answer, err := tt.solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if answer != tt.expected {
+				t.Errorf("answer = %s; want %s", answer, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package days
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+	"github.com/manning0218/adventOfCode/go/utils"
+)
+
+func TestAllDaysRegistered(t *testing.T) {
+	for day := 1; day <= 8; day++ {
+		if _, ok := solver.Lookup(2025, day); !ok {
+			t.Errorf("2025 day %d is not registered", day)
+		}
+	}
+}
+
+// BenchmarkSolvers benchmarks every registered part against its real input,
+// skipping days whose input is not available.
+func BenchmarkSolvers(b *testing.B) {
+	for _, puzzle := range solver.All() {
+		input, err := utils.ReadInputContext(context.Background(), puzzle.Day, puzzle.Year)
+		for part := 1; part <= 2; part++ {
+			b.Run(fmt.Sprintf("%d/day%02d/part%d", puzzle.Year, puzzle.Day, part), func(b *testing.B) {
+				if err != nil {
+					b.Skip(err)
+				}
+				solve := puzzle.Part(part)
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err := solve(input); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -1,3 +0,0 @@
-module github.com/manning0218/adventOfCode/2025/go
-
-go 1.24.5
@@ -0,0 +1,3 @@
+module github.com/manning0218/adventOfCode
+
+go 1.24.5
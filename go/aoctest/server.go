@@ -0,0 +1,249 @@
+// Package aoctest provides an in-process stand-in for adventofcode.com so the
+// input fetcher and answer client can be tested offline.
+package aoctest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+)
+
+// Responses mirroring the text adventofcode.com returns for answer submissions.
+const (
+	CorrectResponse       = "That's the right answer! You are one gold star closer to saving Christmas."
+	TooHighResponse       = "That's not the right answer; your answer is too high. Please wait one minute before trying again."
+	TooLowResponse        = "That's not the right answer; your answer is too low. Please wait one minute before trying again."
+	WrongResponse         = "That's not the right answer. Please wait one minute before trying again."
+	AlreadySolvedResponse = "You don't seem to be solving the right level.  Did you already complete it?"
+	RateLimitedResponse   = "You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 34s left to wait."
+)
+
+type puzzleKey struct{ year, day int }
+
+type answerKey struct{ year, day, part int }
+
+// Server is a fake Advent of Code server backed by httptest.
+type Server struct {
+	*httptest.Server
+
+	// Session is the cookie value the server accepts.
+	Session string
+
+	mu        sync.Mutex
+	inputs    map[puzzleKey]string
+	puzzles   map[puzzleKey]string
+	answers   map[answerKey]int64
+	solved    map[answerKey]bool
+	statuses  map[puzzleKey]int
+	truncated map[puzzleKey]bool
+	throttled bool
+	requests  map[string]int
+}
+
+// NewServer starts a fake server accepting the given session cookie.
+// The server is closed when the test finishes.
+func NewServer(tb testingTB, session string) *Server {
+	s := &Server{
+		Session:   session,
+		inputs:    map[puzzleKey]string{},
+		puzzles:   map[puzzleKey]string{},
+		answers:   map[answerKey]int64{},
+		solved:    map[answerKey]bool{},
+		statuses:  map[puzzleKey]int{},
+		truncated: map[puzzleKey]bool{},
+		requests:  map[string]int{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{year}/day/{day}/input", s.handleInput)
+	mux.HandleFunc("GET /{year}/day/{day}", s.handlePuzzle)
+	mux.HandleFunc("POST /{year}/day/{day}/answer", s.handleAnswer)
+	s.Server = httptest.NewServer(s.count(mux))
+	tb.Cleanup(s.Close)
+
+	return s
+}
+
+// testingTB is the subset of testing.TB used by NewServer.
+type testingTB interface {
+	Cleanup(func())
+}
+
+// SetInput sets the input served for a day.
+func (s *Server) SetInput(year, day int, input string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inputs[puzzleKey{year, day}] = input
+}
+
+// SetPuzzle sets the HTML served for a day's puzzle page.
+func (s *Server) SetPuzzle(year, day int, html string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.puzzles[puzzleKey{year, day}] = html
+}
+
+// SetAnswer sets the correct numeric answer for a part.
+func (s *Server) SetAnswer(year, day, part int, answer int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.answers[answerKey{year, day, part}] = answer
+}
+
+// SetStatus forces every request for a day to fail with the given status.
+// A status of 0 clears the override.
+func (s *Server) SetStatus(year, day, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if status == 0 {
+		delete(s.statuses, puzzleKey{year, day})
+		return
+	}
+	s.statuses[puzzleKey{year, day}] = status
+}
+
+// SetTruncated makes the input for a day break off half way through the
+// response body, as a dropped connection would.
+func (s *Server) SetTruncated(year, day int, truncated bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.truncated[puzzleKey{year, day}] = truncated
+}
+
+// SetThrottled makes every answer submission report a rate limit.
+func (s *Server) SetThrottled(throttled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.throttled = throttled
+}
+
+// Requests returns how many requests were made for a path, e.g. "/2025/day/1/input".
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+// count records every request path before handing it to next.
+func (s *Server) count(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests[r.URL.Path]++
+		s.mu.Unlock()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// authorize checks the session cookie and any forced status, writing an
+// error response and returning false when the request must not proceed.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) (puzzleKey, bool) {
+	year, errYear := strconv.Atoi(r.PathValue("year"))
+	day, errDay := strconv.Atoi(r.PathValue("day"))
+	if errYear != nil || errDay != nil {
+		http.NotFound(w, r)
+		return puzzleKey{}, false
+	}
+	key := puzzleKey{year, day}
+
+	cookie, err := r.Cookie("session")
+	if err != nil || cookie.Value != s.Session {
+		http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+		return key, false
+	}
+
+	s.mu.Lock()
+	status, forced := s.statuses[key]
+	s.mu.Unlock()
+	if forced {
+		http.Error(w, http.StatusText(status), status)
+		return key, false
+	}
+
+	return key, true
+}
+
+func (s *Server) handleInput(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	input, found := s.inputs[key]
+	truncated := s.truncated[key]
+	s.mu.Unlock()
+	if !found {
+		http.Error(w, "Please don't repeatedly request this endpoint before it unlocks!", http.StatusNotFound)
+		return
+	}
+
+	if truncated {
+		w.Header().Set("Content-Length", strconv.Itoa(len(input)))
+		fmt.Fprint(w, input[:len(input)/2])
+		return
+	}
+	fmt.Fprint(w, input)
+}
+
+func (s *Server) handlePuzzle(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	page, found := s.puzzles[key]
+	s.mu.Unlock()
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	fmt.Fprint(w, page)
+}
+
+func (s *Server) handleAnswer(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	part, err := strconv.Atoi(r.FormValue("level"))
+	if err != nil {
+		http.Error(w, "missing level", http.StatusBadRequest)
+		return
+	}
+	akey := answerKey{key.year, key.day, part}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	message := WrongResponse
+	switch want, known := s.answers[akey]; {
+	case s.throttled:
+		message = RateLimitedResponse
+	case s.solved[akey]:
+		message = AlreadySolvedResponse
+	case !known:
+		message = WrongResponse
+	default:
+		got, err := strconv.ParseInt(r.FormValue("answer"), 10, 64)
+		switch {
+		case err != nil:
+			message = WrongResponse
+		case got == want:
+			message = CorrectResponse
+			s.solved[akey] = true
+		case got > want:
+			message = TooHighResponse
+		default:
+			message = TooLowResponse
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	fmt.Fprintf(w, "<html><body><main>\n<article><p>%s</p></article>\n</main></body></html>\n", message)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/utils"
+)
+
+// benchCommand benchmarks one or both parts of a day against the real input.
+func benchCommand(ctx context.Context, args []string) error {
+	args, err := parseFlags(newFlagSet("bench"), args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 1 || len(args) > 2 {
+		return fmt.Errorf("%w: bench takes a day and an optional part", errUsage)
+	}
+	puzzle, err := parseDay(args[0])
+	if err != nil {
+		return err
+	}
+	selected, err := parts(args[1:])
+	if err != nil {
+		return err
+	}
+
+	input, err := utils.ReadInputContext(ctx, puzzle.Day, puzzle.Year)
+	if err != nil {
+		return err
+	}
+
+	for _, part := range selected {
+		solve := puzzle.Part(part)
+		if _, err := solve(input); err != nil {
+			return fmt.Errorf("%s part %d: %w", puzzle, part, err)
+		}
+
+		result := testing.Benchmark(func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				solve(input)
+			}
+		})
+		fmt.Printf("Day %d Part %d: %s %s\n", puzzle.Day, part, result, result.MemString())
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/manning0218/adventOfCode/go/utils"
+)
+
+// cacheCommand lists, verifies or purges the input cache, for every year
+// unless one is given with --year or as purge's argument.
+func cacheCommand(_ context.Context, args []string) error {
+	args, err := parseFlags(newFlagSet("cache"), args)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("%w: cache takes list, verify or purge", errUsage)
+	}
+
+	cache, err := utils.DefaultCache()
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "list":
+		entries, err := cache.List()
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if yearSet && entry.Year != year {
+				continue
+			}
+			fmt.Printf("%d day %02d  %-20s %8d bytes  %s\n", entry.Year, entry.Day, entry.Name, entry.Size, entry.ModTime.Format("2006-01-02 15:04"))
+		}
+	case "verify":
+		problems, err := cache.Verify()
+		if err != nil {
+			return err
+		}
+		problems = slices.DeleteFunc(problems, func(problem utils.CacheProblem) bool {
+			return yearSet && problem.Year != year
+		})
+		for _, problem := range problems {
+			fmt.Println(problem.Err)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("%d cached files failed verification", len(problems))
+		}
+		fmt.Println("cache ok")
+	case "purge":
+		purgeYear := 0
+		if yearSet {
+			purgeYear = year
+		}
+		if len(args) > 1 {
+			if purgeYear, err = strconv.Atoi(args[1]); err != nil {
+				return fmt.Errorf("%w: invalid year %q", errUsage, args[1])
+			}
+		}
+		return cache.Purge(purgeYear)
+	default:
+		return fmt.Errorf("%w: unknown cache operation %q", errUsage, args[0])
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+	"github.com/manning0218/adventOfCode/go/utils"
+)
+
+// fetchCommand downloads inputs and examples for the given days into the
+// cache. The days need not have solvers yet.
+func fetchCommand(ctx context.Context, args []string) error {
+	args, err := parseFlags(newFlagSet("fetch"), args)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("%w: fetch takes at least one day", errUsage)
+	}
+
+	cache, err := utils.DefaultCache()
+	if err != nil {
+		return err
+	}
+	client := utils.NewClient()
+	source := utils.ChainSource{cache, utils.HTTPSource{Client: client, Cache: cache}}
+
+	for _, arg := range args {
+		day, err := parseDayNumber(arg)
+		if err != nil {
+			return err
+		}
+		puzzle := solver.Puzzle{Year: year, Day: day}
+
+		input, err := source.Input(ctx, puzzle.Day, puzzle.Year)
+		if err != nil {
+			return fmt.Errorf("%s input: %w", puzzle, err)
+		}
+		examples, err := utils.FetchExamples(ctx, client, cache, puzzle.Day, puzzle.Year)
+		if err != nil {
+			return fmt.Errorf("%s examples: %w", puzzle, err)
+		}
+		fmt.Printf("Day %d: %d input lines, %d examples\n", puzzle.Day, len(input), len(examples))
+	}
+	return nil
+}
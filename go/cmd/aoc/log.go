@@ -0,0 +1,31 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+// newLogger returns a logger for the solvers' debug output (-v) or debug
+// and trace output (-vv) to stderr. Without either it returns nil, which
+// solvers treat as discarding their output.
+func newLogger(verbose, veryVerbose bool) *slog.Logger {
+	level := slog.LevelDebug
+	switch {
+	case veryVerbose:
+		level = solver.LevelTrace
+	case !verbose:
+		return nil
+	}
+
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.LevelKey && attr.Value.Any() == solver.LevelTrace {
+				attr.Value = slog.StringValue("TRACE")
+			}
+			return attr
+		},
+	}))
+}
@@ -0,0 +1,177 @@
+// Command aoc runs, fetches, submits, tests, benchmarks and verifies the
+// Advent of Code solutions registered with the solver package, scaffolds
+// new days and watches a day for changes while it is being solved.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+	"github.com/manning0218/adventOfCode/go/utils"
+	_ "github.com/manning0218/adventOfCode/go/years"
+)
+
+var (
+	// year is the event year commands work on. It defaults to
+	// utils.DefaultYear and is set by --year before or after the command.
+	year int
+	// yearSet reports whether --year was given explicitly.
+	yearSet bool
+)
+
+// command is one aoc subcommand.
+type command struct {
+	usage string
+	run   func(ctx context.Context, args []string) error
+}
+
+var commands = map[string]command{
+	"run":    {"run [-v|-vv] [--repeat N] [--json] [--cpuprofile F] [--memprofile F] [--trace F] <day> [part] | run --all [--workers N] [--timeout D]", runCommand},
+	"fetch":  {"fetch <day>...", fetchCommand},
+	"submit": {"submit <day> <part> [answer]", submitCommand},
+	"test":   {"test <day>...", testCommand},
+	"bench":  {"bench <day> [part]", benchCommand},
+	"cache":  {"cache list|verify|purge [year]", cacheCommand},
+	"verify": {"verify [--update] [day...]", verifyCommand},
+	"new":    {"new <day>", newCommand},
+	"watch":  {"watch [--interval D] [--debounce D] <day>", watchCommand},
+}
+
+// errUsage marks errors caused by bad arguments rather than failures.
+var errUsage = errors.New("usage")
+
+func main() {
+	var err error
+	if year, err = utils.DefaultYear(time.Now()); err != nil {
+		fmt.Fprintln(os.Stderr, "aoc:", err)
+		os.Exit(1)
+	}
+
+	flag.Usage = usage
+	addYearFlag(flag.CommandLine)
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd.run(context.Background(), flag.Args()[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "aoc:", err)
+		if errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "usage: aoc", cmd.usage)
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc [--year Y] <command> [arguments]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(os.Stderr, "  aoc", commands[name].usage)
+	}
+	fmt.Fprintf(os.Stderr, "\nevery command takes --year, which defaults to AOC_YEAR, the config file or the latest event (now %d)\n", year)
+}
+
+// addYearFlag registers the shared --year flag on fs.
+func addYearFlag(fs *flag.FlagSet) {
+	fs.Func("year", "event `year` to work on", func(arg string) error {
+		y, err := strconv.Atoi(arg)
+		if err != nil {
+			return fmt.Errorf("invalid year %q", arg)
+		}
+		year, yearSet = y, true
+		return nil
+	})
+}
+
+// newFlagSet returns the flag set for a command, with --year registered.
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	addYearFlag(fs)
+	return fs
+}
+
+// parseDay parses a day argument and looks up its registered solver.
+func parseDay(arg string) (solver.Puzzle, error) {
+	day, err := parseDayNumber(arg)
+	if err != nil {
+		return solver.Puzzle{}, err
+	}
+	return lookupDay(day)
+}
+
+// parseDayNumber parses a day argument for commands that need no solver.
+func parseDayNumber(arg string) (int, error) {
+	day, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid day %q", errUsage, arg)
+	}
+	return day, nil
+}
+
+// lookupDay returns the puzzle registered for a day of the selected year.
+func lookupDay(day int) (solver.Puzzle, error) {
+	puzzle, ok := solver.Lookup(year, day)
+	if !ok {
+		return solver.Puzzle{}, fmt.Errorf("no solver registered for %d day %d", year, day)
+	}
+	return puzzle, nil
+}
+
+// parsePart parses a part argument, which must be 1 or 2.
+func parsePart(arg string) (int, error) {
+	part, err := strconv.Atoi(arg)
+	if err != nil || part < 1 || part > 2 {
+		return 0, fmt.Errorf("%w: invalid part %q", errUsage, arg)
+	}
+	return part, nil
+}
+
+// parts returns the parts selected by an optional part argument.
+func parts(args []string) ([]int, error) {
+	if len(args) == 0 {
+		return []int{1, 2}, nil
+	}
+	part, err := parsePart(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return []int{part}, nil
+}
+
+// parseFlags parses fs from args, allowing flags to follow positional
+// arguments, and returns the positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, fmt.Errorf("%w: %v", errUsage, err)
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
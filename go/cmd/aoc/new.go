@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/manning0218/adventOfCode/go/layout"
+	"github.com/manning0218/adventOfCode/go/scaffold"
+	"github.com/manning0218/adventOfCode/go/utils"
+)
+
+// newCommand generates the package, tests and registration for a new day
+// and, when a session is available, saves the puzzle's examples as testdata.
+func newCommand(ctx context.Context, args []string) error {
+	args, err := parseFlags(newFlagSet("new"), args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 1 {
+		return fmt.Errorf("%w: new takes exactly one day", errUsage)
+	}
+	day, err := parseDayNumber(args[0])
+	if err != nil {
+		return err
+	}
+
+	repo, err := findLayout()
+	if err != nil {
+		return err
+	}
+	written, err := scaffold.NewDay(repo, year, day)
+	for _, path := range written {
+		fmt.Println("created", relative(repo.Root, path))
+	}
+	if err != nil {
+		return err
+	}
+
+	examples, err := utils.FetchExamples(ctx, utils.NewClient(), nil, day, year)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "aoc: skipping examples: %v\n", err)
+		return nil
+	}
+	for i, example := range examples {
+		path := filepath.Join(repo.DayDir(year, day), "testdata", fmt.Sprintf("example%d.txt", i+1))
+		if err := writeNew(path, []byte(example)); err != nil {
+			return err
+		}
+		fmt.Println("created", relative(repo.Root, path))
+	}
+	return nil
+}
+
+// findLayout returns the layout of the module holding the working directory.
+func findLayout() (layout.Layout, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return layout.Layout{}, err
+	}
+	return layout.Find(dir)
+}
+
+// writeNew writes data to path, failing if the file already exists.
+func writeNew(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return file.Close()
+}
+
+// relative returns path relative to root for display.
+func relative(root, path string) string {
+	if rel, err := filepath.Rel(root, path); err == nil {
+		return rel
+	}
+	return path
+}
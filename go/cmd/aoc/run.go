@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/manning0218/adventOfCode/go/runner"
+	"github.com/manning0218/adventOfCode/go/solver"
+	"github.com/manning0218/adventOfCode/go/utils"
+)
+
+// runCommand solves one or both parts of a day against the real input and
+// reports the answers with parse and solve timings. With --all it runs every
+// registered day concurrently instead.
+func runCommand(ctx context.Context, args []string) error {
+	fs := newFlagSet("run")
+	repeat := fs.Int("repeat", 1, "run each part `N` times and report min/median/max")
+	asJSON := fs.Bool("json", false, "print the report as JSON")
+	all := fs.Bool("all", false, "run every registered day")
+	workers := fs.Int("workers", runtime.GOMAXPROCS(0), "with --all, run at most `N` days at once")
+	timeout := fs.Duration("timeout", time.Minute, "with --all, give up on a day after this long")
+	verbose := fs.Bool("v", false, "log solver debug output to stderr")
+	veryVerbose := fs.Bool("vv", false, "log solver debug and trace output to stderr")
+	var profiles runner.Profiles
+	fs.StringVar(&profiles.CPU, "cpuprofile", "", "write a CPU profile per part to `file`, named with the day and part")
+	fs.StringVar(&profiles.Mem, "memprofile", "", "write allocation profiles from before and after each part to `file`; compare them with go tool pprof -base, and expect sampling every allocation to slow the run")
+	fs.StringVar(&profiles.Trace, "trace", "", "write an execution trace per part to `file`")
+	top := fs.Int("top", 10, "summarise the `N` hottest functions of each profile")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	logger := newLogger(*verbose, *veryVerbose)
+
+	if *all {
+		if len(args) != 0 {
+			return fmt.Errorf("%w: run --all takes no day", errUsage)
+		}
+		if profiles.Enabled() {
+			return fmt.Errorf("%w: profiles are recorded for a single day", errUsage)
+		}
+		if utils.IgnoresDay(utils.DefaultSource) {
+			return fmt.Errorf("%w: run --all needs an input per day, so AOC_INPUT must be a directory", errUsage)
+		}
+		return runAll(ctx, runner.Options{Workers: *workers, Timeout: *timeout, Repeat: *repeat}, logger, *asJSON)
+	}
+
+	if len(args) < 1 || len(args) > 2 {
+		return fmt.Errorf("%w: run takes a day and an optional part", errUsage)
+	}
+	puzzle, err := parseDay(args[0])
+	if err != nil {
+		return err
+	}
+	puzzle = puzzle.WithLogger(logger)
+	selected, err := parts(args[1:])
+	if err != nil {
+		return err
+	}
+
+	if profiles.Mem != "" {
+		runtime.MemProfileRate = 1
+	}
+	input, err := utils.ReadInputContext(ctx, puzzle.Day, puzzle.Year)
+	if err != nil {
+		return err
+	}
+
+	var report runner.Report
+	var written []string
+	if profiles.Enabled() {
+		report, written, err = runProfiled(puzzle, input, selected, *repeat, profiles)
+	} else {
+		report, err = runner.Run(puzzle, input, selected, *repeat)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		err = runner.WriteJSON(os.Stdout, []runner.Report{report})
+	} else {
+		err = runner.WriteTable(os.Stdout, []runner.Report{report})
+	}
+	if err != nil {
+		return err
+	}
+	return summariseProfiles(ctx, puzzle, selected, written, profiles, *top)
+}
+
+// runProfiled runs each part on its own so every part gets its own profiles,
+// and returns the combined report and the profiles written.
+func runProfiled(puzzle solver.Puzzle, input []string, selected []int, repeat int, profiles runner.Profiles) (runner.Report, []string, error) {
+	report := runner.Report{Year: puzzle.Year, Day: puzzle.Day}
+	var written []string
+	for _, part := range selected {
+		files, err := profiles.Record(puzzle, part, func() error {
+			partReport, err := runner.Run(puzzle, input, []int{part}, repeat)
+			report.Repeat = partReport.Repeat
+			report.Parts = append(report.Parts, partReport.Parts...)
+			return err
+		})
+		written = append(written, files...)
+		if err != nil {
+			return report, written, err
+		}
+	}
+	return report, written, nil
+}
+
+// summariseProfiles lists the profiles written to stderr, followed by the
+// hottest functions of each CPU profile and of what each part allocated.
+func summariseProfiles(ctx context.Context, puzzle solver.Puzzle, selected []int, written []string, profiles runner.Profiles, top int) error {
+	for _, name := range written {
+		fmt.Fprintln(os.Stderr, "wrote", name)
+	}
+	if top <= 0 {
+		return nil
+	}
+
+	for _, part := range selected {
+		for _, base := range []string{profiles.CPU, profiles.Mem} {
+			if base == "" {
+				continue
+			}
+			name := runner.ProfileName(base, puzzle, part)
+			var diff string
+			if base == profiles.Mem {
+				diff = runner.BaseProfileName(name)
+			}
+			summary, err := runner.TopFunctions(ctx, name, diff, top)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "\n%s\n%s", name, summary)
+		}
+	}
+	return nil
+}
+
+// runAll runs every registered day of the year, each solver logging to
+// logger, and prints a calendar of the results, failing if any day did.
+func runAll(ctx context.Context, opts runner.Options, logger *slog.Logger, asJSON bool) error {
+	opts.Input = func(ctx context.Context, puzzle solver.Puzzle) ([]string, error) {
+		return utils.ReadInputContext(ctx, puzzle.Day, puzzle.Year)
+	}
+	puzzles := solver.Year(year)
+	for i := range puzzles {
+		puzzles[i] = puzzles[i].WithLogger(logger)
+	}
+	summary := runner.RunAll(ctx, puzzles, opts)
+
+	if asJSON {
+		reports := make([]runner.Report, 0, len(summary.Results))
+		for _, result := range summary.Results {
+			if result.Err == nil {
+				reports = append(reports, result.Report)
+			}
+		}
+		if err := runner.WriteJSON(os.Stdout, reports); err != nil {
+			return err
+		}
+	} else if err := runner.WriteCalendar(os.Stdout, summary); err != nil {
+		return err
+	}
+
+	if failed := len(summary.Failed()); failed > 0 {
+		return fmt.Errorf("%d of %d days failed", failed, len(summary.Results))
+	}
+	return nil
+}
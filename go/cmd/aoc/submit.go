@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+	"github.com/manning0218/adventOfCode/go/utils"
+)
+
+// submitCommand posts an answer, solving the part first if none is given.
+// Only solving needs a registered solver.
+func submitCommand(ctx context.Context, args []string) error {
+	args, err := parseFlags(newFlagSet("submit"), args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 || len(args) > 3 {
+		return fmt.Errorf("%w: submit takes a day, a part and an optional answer", errUsage)
+	}
+	day, err := parseDayNumber(args[0])
+	if err != nil {
+		return err
+	}
+	puzzle := solver.Puzzle{Year: year, Day: day}
+	part, err := parsePart(args[1])
+	if err != nil {
+		return err
+	}
+
+	var answer string
+	if len(args) == 3 {
+		answer = args[2]
+	} else {
+		if puzzle, err = lookupDay(day); err != nil {
+			return err
+		}
+		input, err := utils.ReadInputContext(ctx, puzzle.Day, puzzle.Year)
+		if err != nil {
+			return err
+		}
+		if answer, err = puzzle.Part(part)(input); err != nil {
+			return fmt.Errorf("%s part %d: %w", puzzle, part, err)
+		}
+	}
+
+	answers, ledger, err := openAnswerStores()
+	if err != nil {
+		return err
+	}
+
+	client := utils.NewClient(utils.WithLedger(ledger))
+	result, err := client.Submit(ctx, puzzle.Day, puzzle.Year, part, answer)
+	if err != nil {
+		return err
+	}
+
+	switch result.Verdict {
+	case utils.Correct:
+		fmt.Printf("Day %d Part %d: %s is correct\n", puzzle.Day, part, answer)
+		return answers.Set(puzzle.Year, puzzle.Day, part, answer)
+	case utils.RateLimited:
+		return fmt.Errorf("rate limited, try again in %v", result.Wait)
+	case utils.Unknown:
+		return fmt.Errorf("unrecognised response: %s", result.Message)
+	default:
+		return fmt.Errorf("%s part %d: %s is %s", puzzle, part, answer, result.Verdict)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+const modulePath = "github.com/manning0218/adventOfCode"
+
+// dayPackage returns the import path of a puzzle's package.
+func dayPackage(puzzle solver.Puzzle) string {
+	return fmt.Sprintf("%s/%d/go/day%02d", modulePath, puzzle.Year, puzzle.Day)
+}
+
+// testCommand runs go test for the given days' packages.
+func testCommand(ctx context.Context, args []string) error {
+	args, err := parseFlags(newFlagSet("test"), args)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("%w: test takes at least one day", errUsage)
+	}
+
+	goArgs := []string{"test"}
+	for _, arg := range args {
+		puzzle, err := parseDay(arg)
+		if err != nil {
+			return err
+		}
+		goArgs = append(goArgs, dayPackage(puzzle))
+	}
+
+	cmd := exec.CommandContext(ctx, "go", goArgs...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/manning0218/adventOfCode/go/runner"
+	"github.com/manning0218/adventOfCode/go/solver"
+	"github.com/manning0218/adventOfCode/go/utils"
+)
+
+// verifyCommand checks every solution for the year, or the given days, against
+// the expected answers, using only cached inputs. It fails on any regression.
+func verifyCommand(ctx context.Context, args []string) error {
+	fs := newFlagSet("verify")
+	update := fs.Bool("update", false, "record answers for parts with no expected answer")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	puzzles := solver.Year(year)
+	if len(args) > 0 {
+		puzzles = puzzles[:0]
+		for _, arg := range args {
+			puzzle, err := parseDay(arg)
+			if err != nil {
+				return err
+			}
+			puzzles = append(puzzles, puzzle)
+		}
+	}
+
+	answers, ledger, err := openAnswerStores()
+	if err != nil {
+		return err
+	}
+	cache, err := utils.DefaultCache()
+	if err != nil {
+		return err
+	}
+
+	var checks []runner.Check
+	for _, puzzle := range puzzles {
+		input, err := cache.Input(ctx, puzzle.Day, puzzle.Year)
+		if err != nil {
+			for part := 1; part <= 2; part++ {
+				checks = append(checks, runner.Check{Year: puzzle.Year, Day: puzzle.Day, Part: part, Status: runner.Skipped})
+			}
+			continue
+		}
+
+		expected := func(part int) (string, bool) {
+			if answer, ok := answers.Get(puzzle.Year, puzzle.Day, part); ok {
+				return answer, true
+			}
+			return ledger.Correct(puzzle.Year, puzzle.Day, part)
+		}
+		for _, check := range runner.Verify(puzzle, input, expected) {
+			if check.Status == runner.Unknown && *update {
+				if err := answers.Set(check.Year, check.Day, check.Part, check.Got); err != nil {
+					return err
+				}
+			}
+			checks = append(checks, check)
+		}
+	}
+
+	if err := runner.WriteChecks(os.Stdout, checks); err != nil {
+		return err
+	}
+	if runner.Failed(checks) {
+		return errors.New("verification failed")
+	}
+	return nil
+}
+
+// openAnswerStores opens the expected answers file and the submission ledger.
+func openAnswerStores() (*utils.Answers, *utils.Ledger, error) {
+	answersPath, err := utils.DefaultAnswersPath()
+	if err != nil {
+		return nil, nil, err
+	}
+	answers, err := utils.OpenAnswers(answersPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ledgerPath, err := utils.DefaultLedgerPath()
+	if err != nil {
+		return nil, nil, err
+	}
+	ledger, err := utils.OpenLedger(ledgerPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return answers, ledger, nil
+}
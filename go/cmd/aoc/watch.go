@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/manning0218/adventOfCode/go/runner"
+	"github.com/manning0218/adventOfCode/go/solver"
+	"github.com/manning0218/adventOfCode/go/watch"
+)
+
+// watchCommand re-runs a day's example tests and then its real input every
+// time the package's sources or testdata change, until interrupted.
+func watchCommand(ctx context.Context, args []string) error {
+	fs := newFlagSet("watch")
+	interval := fs.Duration("interval", 250*time.Millisecond, "how often to poll for changes")
+	debounce := fs.Duration("debounce", 300*time.Millisecond, "how long changes must settle before re-running")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return fmt.Errorf("%w: watch takes exactly one day", errUsage)
+	}
+	puzzle, err := parseDay(args[0])
+	if err != nil {
+		return err
+	}
+	repo, err := findLayout()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	dir := repo.DayDir(puzzle.Year, puzzle.Day)
+	w := watch.Watcher{
+		Dirs:     []string{dir},
+		Interval: *interval,
+		Debounce: *debounce,
+	}
+	fmt.Printf("watching %s, press Ctrl-C to stop\n", relative(repo.Root, dir))
+	return w.Run(ctx, func() {
+		fmt.Println(watchRound(ctx, repo.Root, puzzle))
+	})
+}
+
+// watchRound runs the example tests and, if they pass, the real input, and
+// returns a one-line summary. Failure output is printed as it is found.
+// Both steps go through the go tool so each round picks up the edited code.
+func watchRound(ctx context.Context, root string, puzzle solver.Puzzle) string {
+	summary := []string{time.Now().Format(time.TimeOnly)}
+
+	out, err := goCommand(ctx, root, "test", dayPackage(puzzle))
+	if err != nil {
+		if ctx.Err() != nil {
+			return strings.Join(append(summary, "interrupted"), "  ")
+		}
+		os.Stdout.Write(out)
+		return strings.Join(append(summary, "tests FAIL"), "  ")
+	}
+	summary = append(summary, "tests ok")
+
+	out, err = goCommand(ctx, root, "run", modulePath+"/go/cmd/aoc", "run", "--json", "--year", fmt.Sprint(puzzle.Year), fmt.Sprint(puzzle.Day))
+	if err != nil {
+		if ctx.Err() != nil {
+			return strings.Join(append(summary, "interrupted"), "  ")
+		}
+		os.Stdout.Write(out)
+		return strings.Join(append(summary, "run FAIL"), "  ")
+	}
+
+	var reports []runner.Report
+	if err := json.Unmarshal(out, &reports); err != nil {
+		return strings.Join(append(summary, fmt.Sprintf("run output unreadable: %v", err)), "  ")
+	}
+	for _, report := range reports {
+		for _, part := range report.Parts {
+			summary = append(summary, fmt.Sprintf("part %d: %s (%s)",
+				part.Part, part.Answer, part.Solve.Median.Round(time.Microsecond)))
+		}
+	}
+	return strings.Join(summary, "  ")
+}
+
+// goCommand runs the go tool in dir. It returns stdout on success and the
+// combined output on failure.
+func goCommand(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return append(stdout.Bytes(), stderr.Bytes()...), err
+	}
+	return stdout.Bytes(), nil
+}
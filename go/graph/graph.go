@@ -0,0 +1,206 @@
+// Package graph provides breadth-first, depth-first, Dijkstra and A* search
+// over any type that can list a node's neighbours.
+package graph
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// Edge is a weighted edge to the node To. Costs must not be negative.
+type Edge[N comparable] struct {
+	To   N
+	Cost int
+}
+
+// Graph lists the outgoing edges of a node.
+type Graph[N comparable] interface {
+	Neighbors(node N) []Edge[N]
+}
+
+// Func adapts an ordinary function to a Graph.
+type Func[N comparable] func(node N) []Edge[N]
+
+// Neighbors calls f(node).
+func (f Func[N]) Neighbors(node N) []Edge[N] { return f(node) }
+
+// Result is the outcome of a search.
+type Result[N comparable] struct {
+	// Dist holds the cost of reaching every reached node from the nearest
+	// source. BFS and DFS count edges; Dijkstra and AStar add up costs. After
+	// an early exit, nodes still on the frontier may not have their final cost.
+	Dist map[N]int
+	// Goal is the node that satisfied the goal function, if Found.
+	Goal  N
+	Found bool
+
+	prev map[N]N
+}
+
+func newResult[N comparable]() Result[N] {
+	return Result[N]{Dist: map[N]int{}, prev: map[N]N{}}
+}
+
+// Reached reports whether the search reached node.
+func (r Result[N]) Reached(node N) bool {
+	_, ok := r.Dist[node]
+	return ok
+}
+
+// Path returns the nodes from a source to node inclusive, or nil if node
+// was not reached.
+func (r Result[N]) Path(node N) []N {
+	if !r.Reached(node) {
+		return nil
+	}
+	path := []N{node}
+	for {
+		prev, ok := r.prev[node]
+		if !ok {
+			break
+		}
+		path = append(path, prev)
+		node = prev
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// BFS searches outward from sources one edge at a time, ignoring costs.
+// goal is called once per node in visiting order and the search stops when
+// it returns true; a nil goal explores everything reachable.
+func BFS[N comparable](g Graph[N], sources []N, goal func(N) bool) Result[N] {
+	r := newResult[N]()
+	var queue []N
+	for _, s := range sources {
+		if !r.Reached(s) {
+			r.Dist[s] = 0
+			queue = append(queue, s)
+		}
+	}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if goal != nil && goal(node) {
+			r.Goal, r.Found = node, true
+			return r
+		}
+		for _, e := range g.Neighbors(node) {
+			if !r.Reached(e.To) {
+				r.Dist[e.To] = r.Dist[node] + 1
+				r.prev[e.To] = node
+				queue = append(queue, e.To)
+			}
+		}
+	}
+	return r
+}
+
+// DFS searches as deep as possible before backtracking, taking each node's
+// edges in order and ignoring costs. goal behaves as for BFS.
+func DFS[N comparable](g Graph[N], sources []N, goal func(N) bool) Result[N] {
+	type item struct {
+		node, from N
+		root       bool
+	}
+	r := newResult[N]()
+	stack := make([]item, 0, len(sources))
+	for _, s := range slices.Backward(sources) {
+		stack = append(stack, item{node: s, root: true})
+	}
+	for len(stack) > 0 {
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if r.Reached(it.node) {
+			continue
+		}
+		if it.root {
+			r.Dist[it.node] = 0
+		} else {
+			r.Dist[it.node] = r.Dist[it.from] + 1
+			r.prev[it.node] = it.from
+		}
+		if goal != nil && goal(it.node) {
+			r.Goal, r.Found = it.node, true
+			return r
+		}
+		for _, e := range slices.Backward(g.Neighbors(it.node)) {
+			if !r.Reached(e.To) {
+				stack = append(stack, item{node: e.To, from: it.node})
+			}
+		}
+	}
+	return r
+}
+
+// Dijkstra finds the cheapest cost from any source to every node, settling
+// nodes in order of cost. goal is called as each node is settled and the
+// search stops when it returns true; a nil goal explores everything.
+func Dijkstra[N comparable](g Graph[N], sources []N, goal func(N) bool) Result[N] {
+	return AStar(g, sources, goal, nil)
+}
+
+// AStar is Dijkstra guided by h, an estimate of the remaining cost to the
+// goal. The first goal settled is the cheapest as long as h never
+// overestimates. An h that is not also consistent can settle a node before
+// its cheapest path is known; AStar then reopens the node when that path
+// turns up. A nil h makes AStar the same as Dijkstra.
+func AStar[N comparable](g Graph[N], sources []N, goal func(N) bool, h func(N) int) Result[N] {
+	estimate := func(N) int { return 0 }
+	if h != nil {
+		estimate = h
+	}
+
+	r := newResult[N]()
+	frontier := &queue[N]{}
+	for _, s := range sources {
+		if !r.Reached(s) {
+			r.Dist[s] = 0
+			heap.Push(frontier, entry[N]{node: s, priority: estimate(s)})
+		}
+	}
+	for frontier.Len() > 0 {
+		e := heap.Pop(frontier).(entry[N])
+		node := e.node
+		// An entry is stale once a cheaper path to its node was pushed.
+		if e.cost != r.Dist[node] {
+			continue
+		}
+		if goal != nil && goal(node) {
+			r.Goal, r.Found = node, true
+			return r
+		}
+		for _, e := range g.Neighbors(node) {
+			cost := r.Dist[node] + e.Cost
+			if best, ok := r.Dist[e.To]; ok && best <= cost {
+				continue
+			}
+			r.Dist[e.To] = cost
+			r.prev[e.To] = node
+			heap.Push(frontier, entry[N]{node: e.To, cost: cost, priority: cost + estimate(e.To)})
+		}
+	}
+	return r
+}
+
+// entry is a frontier node, with the cost it was reached at, ordered by
+// priority.
+type entry[N comparable] struct {
+	node     N
+	cost     int
+	priority int
+}
+
+// queue is a min-heap of entries for container/heap.
+type queue[N comparable] []entry[N]
+
+func (q queue[N]) Len() int           { return len(q) }
+func (q queue[N]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q queue[N]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue[N]) Push(x any)        { *q = append(*q, x.(entry[N])) }
+func (q *queue[N]) Pop() any {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return e
+}
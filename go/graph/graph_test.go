@@ -0,0 +1,156 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/grid"
+)
+
+// weighted is a small directed graph where the fewest-edges route from a to
+// d costs more than the cheapest one:
+//
+//	a -1-> b -1-> c -1-> d
+//	a ------------10---> d
+var weighted = Func[string](func(n string) []Edge[string] {
+	return map[string][]Edge[string]{
+		"a": {{To: "d", Cost: 10}, {To: "b", Cost: 1}},
+		"b": {{To: "c", Cost: 1}},
+		"c": {{To: "d", Cost: 1}},
+	}[n]
+})
+
+func is[N comparable](target N) func(N) bool {
+	return func(n N) bool { return n == target }
+}
+
+func TestSearches(t *testing.T) {
+	tests := []struct {
+		name     string
+		search   func(Graph[string], []string, func(string) bool) Result[string]
+		path     []string
+		distance int
+	}{
+		{"BFS", BFS[string], []string{"a", "d"}, 1},
+		{"DFS", DFS[string], []string{"a", "d"}, 1},
+		{"Dijkstra", Dijkstra[string], []string{"a", "b", "c", "d"}, 3},
+		{"AStar", func(g Graph[string], s []string, goal func(string) bool) Result[string] {
+			return AStar(g, s, goal, func(string) int { return 0 })
+		}, []string{"a", "b", "c", "d"}, 3},
+	}
+
+	for _, tt := range tests {
+		r := tt.search(weighted, []string{"a"}, is("d"))
+		if !r.Found || r.Goal != "d" {
+			t.Errorf("%s: Found = %t, Goal = %q; want d", tt.name, r.Found, r.Goal)
+			continue
+		}
+		if got := r.Path("d"); !slices.Equal(got, tt.path) {
+			t.Errorf("%s: Path(d) = %v; want %v", tt.name, got, tt.path)
+		}
+		if got := r.Dist["d"]; got != tt.distance {
+			t.Errorf("%s: Dist[d] = %d; want %d", tt.name, got, tt.distance)
+		}
+	}
+}
+
+func TestUnreachable(t *testing.T) {
+	r := BFS[string](weighted, []string{"c"}, is("a"))
+	if r.Found {
+		t.Error("BFS() found an unreachable goal")
+	}
+	if r.Path("a") != nil {
+		t.Errorf("Path(a) = %v; want nil", r.Path("a"))
+	}
+	if !r.Reached("d") || r.Reached("b") {
+		t.Errorf("Dist = %v; want c and d only", r.Dist)
+	}
+}
+
+func TestMultiSourceAndEarlyExit(t *testing.T) {
+	g, err := grid.Parse([]string{
+		"S...#",
+		".##.#",
+		"....S",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	open := Grid(g, func(_, to rune) bool { return to != '#' })
+	sources := grid.FindAll(g, 'S')
+
+	r := BFS(open, sources, nil)
+	reachable := len(r.Dist)
+	if got := r.Dist[grid.Point{Row: 0, Col: 3}]; got != 3 {
+		t.Errorf("Dist[{0 3}] = %d; want 3 from the nearer source", got)
+	}
+	if r.Reached(grid.Point{Row: 0, Col: 4}) {
+		t.Error("BFS() reached a wall")
+	}
+
+	visited := 0
+	r = BFS(open, sources, func(p grid.Point) bool {
+		visited++
+		return p == grid.Point{Row: 2, Col: 3}
+	})
+	if !r.Found || visited >= reachable {
+		t.Errorf("early exit: Found = %t after %d of %d visits; want true sooner", r.Found, visited, reachable)
+	}
+}
+
+func TestAStarManhattan(t *testing.T) {
+	g := grid.New[rune](10, 10)
+	g.Set(grid.Point{Row: 0, Col: 5}, '#')
+	open := Grid(g, func(_, to rune) bool { return to != '#' })
+	target := grid.Point{Row: 0, Col: 9}
+	manhattan := func(p grid.Point) int {
+		return abs(target.Row-p.Row) + abs(target.Col-p.Col)
+	}
+
+	astar := AStar(open, []grid.Point{{}}, is(target), manhattan)
+	dijkstra := Dijkstra(open, []grid.Point{{}}, is(target))
+	if astar.Dist[target] != 11 || dijkstra.Dist[target] != 11 {
+		t.Errorf("cost = %d (A*), %d (Dijkstra); want 11", astar.Dist[target], dijkstra.Dist[target])
+	}
+	if len(astar.Dist) >= len(dijkstra.Dist) {
+		t.Errorf("A* reached %d nodes, Dijkstra %d; want A* to reach fewer", len(astar.Dist), len(dijkstra.Dist))
+	}
+}
+
+func TestAStarInconsistentHeuristic(t *testing.T) {
+	// s reaches a directly for 4 or through b for 2. The heuristic never
+	// overestimates but is inconsistent at b, so a is settled at 4 before
+	// the cheaper path is found and must be reopened.
+	g := Func[string](func(n string) []Edge[string] {
+		switch n {
+		case "s":
+			return []Edge[string]{{To: "a", Cost: 4}, {To: "b", Cost: 1}}
+		case "b":
+			return []Edge[string]{{To: "a", Cost: 1}}
+		case "a":
+			return []Edge[string]{{To: "g", Cost: 5}}
+		}
+		return nil
+	})
+	h := func(n string) int {
+		if n == "b" {
+			return 6
+		}
+		return 0
+	}
+
+	r := AStar(g, []string{"s"}, is("g"), h)
+	if !r.Found || r.Dist["g"] != 7 {
+		t.Errorf("AStar() cost = %d, found %t; want 7, true", r.Dist["g"], r.Found)
+	}
+	if got := r.Path("g"); !slices.Equal(got, []string{"s", "b", "a", "g"}) {
+		t.Errorf("AStar() path = %v; want [s b a g]", got)
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,20 @@
+package graph
+
+import (
+	"github.com/manning0218/adventOfCode/go/grid"
+)
+
+// Grid views g as a graph over its points with unit-cost edges to the
+// orthogonal neighbours for which open(from, to) is true. The grid is read in
+// place, not copied.
+func Grid[T any](g grid.Grid[T], open func(from, to T) bool) Func[grid.Point] {
+	return func(p grid.Point) []Edge[grid.Point] {
+		var edges []Edge[grid.Point]
+		for q := range g.Neighbors4(p) {
+			if open(g.At(p), g.At(q)) {
+				edges = append(edges, Edge[grid.Point]{To: q, Cost: 1})
+			}
+		}
+		return edges
+	}
+}
@@ -0,0 +1,240 @@
+// Package grid provides a generic rectangular grid for grid-shaped puzzles.
+package grid
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/manning0218/adventOfCode/go/parse"
+)
+
+// Point is a cell position. Row counts down from the top and Col right from
+// the left, matching how puzzle input reads.
+type Point struct{ Row, Col int }
+
+// Add returns p moved by the offset q.
+func (p Point) Add(q Point) Point {
+	return Point{Row: p.Row + q.Row, Col: p.Col + q.Col}
+}
+
+// Offsets to neighbouring cells.
+var (
+	North     = Point{-1, 0}
+	South     = Point{1, 0}
+	West      = Point{0, -1}
+	East      = Point{0, 1}
+	NorthWest = Point{-1, -1}
+	NorthEast = Point{-1, 1}
+	SouthWest = Point{1, -1}
+	SouthEast = Point{1, 1}
+
+	// Orthogonal holds the four edge-sharing directions, clockwise from north.
+	Orthogonal = []Point{North, East, South, West}
+	// Adjacent holds all eight directions in reading order.
+	Adjacent = []Point{NorthWest, North, NorthEast, West, East, SouthWest, South, SouthEast}
+)
+
+// Grid is a rectangular grid of cells. It has the reference semantics of a
+// slice: copies and subgrid views share cells, while Clone makes a new grid.
+type Grid[T any] struct {
+	cells         []T
+	offset        int
+	stride        int
+	width, height int
+}
+
+// New returns a width by height grid of zero values.
+func New[T any](width, height int) Grid[T] {
+	return Grid[T]{cells: make([]T, width*height), stride: width, width: width, height: height}
+}
+
+// FromRows copies rows into a new grid. Every row must be as wide as the first.
+func FromRows[T any](rows [][]T) (Grid[T], error) {
+	if len(rows) == 0 {
+		return Grid[T]{}, nil
+	}
+	g := New[T](len(rows[0]), len(rows))
+	for row, cells := range rows {
+		if len(cells) != g.width {
+			return Grid[T]{}, &parse.Error{Line: row + 1, Err: fmt.Errorf("row has %d columns, want %d", len(cells), g.width)}
+		}
+		copy(g.cells[row*g.stride:], cells)
+	}
+	return g, nil
+}
+
+// Parse reads a grid of runes, one row per line.
+func Parse(lines []string) (Grid[rune], error) {
+	rows, err := parse.Grid(lines)
+	if err != nil {
+		return Grid[rune]{}, err
+	}
+	return FromRows(rows)
+}
+
+// Width returns the number of columns.
+func (g Grid[T]) Width() int { return g.width }
+
+// Height returns the number of rows.
+func (g Grid[T]) Height() int { return g.height }
+
+// InBounds reports whether p is a cell of the grid.
+func (g Grid[T]) InBounds(p Point) bool {
+	return p.Row >= 0 && p.Row < g.height && p.Col >= 0 && p.Col < g.width
+}
+
+// index returns the position of an in-bounds point in cells.
+func (g Grid[T]) index(p Point) int {
+	return g.offset + p.Row*g.stride + p.Col
+}
+
+// At returns the cell at p, which must be in bounds.
+func (g Grid[T]) At(p Point) T {
+	if !g.InBounds(p) {
+		panic(fmt.Sprintf("grid: %v out of bounds for %dx%d grid", p, g.width, g.height))
+	}
+	return g.cells[g.index(p)]
+}
+
+// Get returns the cell at p and whether p is in bounds.
+func (g Grid[T]) Get(p Point) (T, bool) {
+	if !g.InBounds(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[g.index(p)], true
+}
+
+// Set changes the cell at p, which must be in bounds.
+func (g Grid[T]) Set(p Point, v T) {
+	if !g.InBounds(p) {
+		panic(fmt.Sprintf("grid: %v out of bounds for %dx%d grid", p, g.width, g.height))
+	}
+	g.cells[g.index(p)] = v
+}
+
+// All yields every point and its cell in reading order.
+func (g Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for row := 0; row < g.height; row++ {
+			for col := 0; col < g.width; col++ {
+				p := Point{row, col}
+				if !yield(p, g.cells[g.index(p)]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Neighbors yields the in-bounds points at the given offsets from p.
+func (g Grid[T]) Neighbors(p Point, offsets []Point) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, offset := range offsets {
+			if q := p.Add(offset); g.InBounds(q) && !yield(q) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors4 yields the in-bounds orthogonal neighbours of p.
+func (g Grid[T]) Neighbors4(p Point) iter.Seq[Point] {
+	return g.Neighbors(p, Orthogonal)
+}
+
+// Neighbors8 yields the in-bounds orthogonal and diagonal neighbours of p.
+func (g Grid[T]) Neighbors8(p Point) iter.Seq[Point] {
+	return g.Neighbors(p, Adjacent)
+}
+
+// Find returns the first point in reading order holding v.
+func Find[T comparable](g Grid[T], v T) (Point, bool) {
+	for p, cell := range g.All() {
+		if cell == v {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// FindAll returns every point holding v in reading order.
+func FindAll[T comparable](g Grid[T], v T) []Point {
+	var points []Point
+	for p, cell := range g.All() {
+		if cell == v {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// Clone returns a copy of the grid that shares no cells with it.
+func (g Grid[T]) Clone() Grid[T] {
+	return g.remap(g.width, g.height, func(p Point) Point { return p })
+}
+
+// Sub returns a view of the width by height subgrid whose top-left cell is
+// origin. The view shares cells with g; it panics if it does not fit.
+func (g Grid[T]) Sub(origin Point, width, height int) Grid[T] {
+	if width < 0 || height < 0 || origin.Row < 0 || origin.Col < 0 ||
+		origin.Row+height > g.height || origin.Col+width > g.width {
+		panic(fmt.Sprintf("grid: %dx%d subgrid at %v does not fit %dx%d grid", width, height, origin, g.width, g.height))
+	}
+	return Grid[T]{cells: g.cells, offset: g.index(origin), stride: g.stride, width: width, height: height}
+}
+
+// Transpose returns a new grid with rows and columns swapped.
+func (g Grid[T]) Transpose() Grid[T] {
+	return g.remap(g.height, g.width, func(p Point) Point { return Point{p.Col, p.Row} })
+}
+
+// Rotate returns a new grid turned a quarter turn clockwise.
+func (g Grid[T]) Rotate() Grid[T] {
+	return g.remap(g.height, g.width, func(p Point) Point { return Point{g.height - 1 - p.Col, p.Row} })
+}
+
+// FlipHorizontal returns a new grid mirrored left to right.
+func (g Grid[T]) FlipHorizontal() Grid[T] {
+	return g.remap(g.width, g.height, func(p Point) Point { return Point{p.Row, g.width - 1 - p.Col} })
+}
+
+// FlipVertical returns a new grid mirrored top to bottom.
+func (g Grid[T]) FlipVertical() Grid[T] {
+	return g.remap(g.width, g.height, func(p Point) Point { return Point{g.height - 1 - p.Row, p.Col} })
+}
+
+// remap builds a width by height grid whose cell at p is g's cell at from(p).
+func (g Grid[T]) remap(width, height int, from func(Point) Point) Grid[T] {
+	out := New[T](width, height)
+	for row := 0; row < height; row++ {
+		for col := 0; col < width; col++ {
+			p := Point{row, col}
+			out.cells[out.index(p)] = g.cells[g.index(from(p))]
+		}
+	}
+	return out
+}
+
+// String renders the grid one row per line. Runes, bytes and strings are
+// written as text and other cells with fmt.Sprint.
+func (g Grid[T]) String() string {
+	var b strings.Builder
+	for row := 0; row < g.height; row++ {
+		for col := 0; col < g.width; col++ {
+			switch cell := any(g.cells[g.index(Point{row, col})]).(type) {
+			case rune:
+				b.WriteRune(cell)
+			case byte:
+				b.WriteByte(cell)
+			case string:
+				b.WriteString(cell)
+			default:
+				fmt.Fprint(&b, cell)
+			}
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
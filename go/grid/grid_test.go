@@ -0,0 +1,124 @@
+package grid
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/parse"
+)
+
+func mustParse(t *testing.T, lines ...string) Grid[rune] {
+	t.Helper()
+	g, err := Parse(lines)
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", lines, err)
+	}
+	return g
+}
+
+func TestParse(t *testing.T) {
+	g := mustParse(t, "abc", "def")
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Fatalf("size = %dx%d; want 3x2", g.Width(), g.Height())
+	}
+	if got := g.At(Point{1, 2}); got != 'f' {
+		t.Errorf("At({1 2}) = %q; want 'f'", got)
+	}
+	if _, ok := g.Get(Point{2, 0}); ok {
+		t.Error("Get({2 0}) reported an out-of-bounds point as present")
+	}
+
+	_, err := Parse([]string{"abc", "de"})
+	var perr *parse.Error
+	if !errors.As(err, &perr) || perr.Line != 2 {
+		t.Errorf("Parse(ragged) error = %v; want a parse.Error on line 2", err)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := New[int](3, 3)
+	tests := []struct {
+		name     string
+		p        Point
+		fn       func(Point) []Point
+		expected []Point
+	}{
+		{"corner4", Point{0, 0}, func(p Point) []Point { return slices.Collect(g.Neighbors4(p)) }, []Point{{0, 1}, {1, 0}}},
+		{"centre4", Point{1, 1}, func(p Point) []Point { return slices.Collect(g.Neighbors4(p)) }, []Point{{0, 1}, {1, 2}, {2, 1}, {1, 0}}},
+		{"corner8", Point{2, 2}, func(p Point) []Point { return slices.Collect(g.Neighbors8(p)) }, []Point{{1, 1}, {1, 2}, {2, 1}}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.fn(tt.p); !slices.Equal(got, tt.expected) {
+			t.Errorf("%s: neighbours of %v = %v; want %v", tt.name, tt.p, got, tt.expected)
+		}
+	}
+	if n := len(slices.Collect(g.Neighbors8(Point{1, 1}))); n != 8 {
+		t.Errorf("Neighbors8(centre) yielded %d points; want 8", n)
+	}
+}
+
+func TestFind(t *testing.T) {
+	g := mustParse(t, ".@.", "@.@")
+	expected := []Point{{0, 1}, {1, 0}, {1, 2}}
+	if got := FindAll(g, '@'); !slices.Equal(got, expected) {
+		t.Errorf("FindAll('@') = %v; want %v", got, expected)
+	}
+	if p, ok := Find(g, '@'); !ok || p != (Point{0, 1}) {
+		t.Errorf("Find('@') = %v, %t; want {0 1}, true", p, ok)
+	}
+	if _, ok := Find(g, '#'); ok {
+		t.Error("Find('#') found a missing value")
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	g := mustParse(t, "abc", "def")
+	tests := []struct {
+		name     string
+		got      Grid[rune]
+		expected string
+	}{
+		{"Rotate", g.Rotate(), "da\neb\nfc\n"},
+		{"Transpose", g.Transpose(), "ad\nbe\ncf\n"},
+		{"FlipHorizontal", g.FlipHorizontal(), "cba\nfed\n"},
+		{"FlipVertical", g.FlipVertical(), "def\nabc\n"},
+		{"Rotate x4", g.Rotate().Rotate().Rotate().Rotate(), "abc\ndef\n"},
+		{"Sub", g.Sub(Point{0, 1}, 2, 2), "bc\nef\n"},
+		{"Sub.Rotate", g.Sub(Point{0, 1}, 2, 2).Rotate(), "eb\nfc\n"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.expected {
+			t.Errorf("%s = %q; want %q", tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestCloneAndSubSharing(t *testing.T) {
+	g := mustParse(t, "abc", "def")
+	clone := g.Clone()
+	sub := g.Sub(Point{1, 1}, 2, 1)
+
+	sub.Set(Point{0, 0}, 'X')
+	if got := g.At(Point{1, 1}); got != 'X' {
+		t.Errorf("write through Sub not visible in parent: At({1 1}) = %q", got)
+	}
+	if got := clone.At(Point{1, 1}); got != 'e' {
+		t.Errorf("write through Sub visible in Clone: At({1 1}) = %q", got)
+	}
+	if sub.InBounds(Point{0, 2}) {
+		t.Error("Sub reports a point outside the view as in bounds")
+	}
+}
+
+func TestString(t *testing.T) {
+	g, err := FromRows([][]int{{1, 2}, {3, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := g.String(), "12\n34\n"; got != expected {
+		t.Errorf("String() = %q; want %q", got, expected)
+	}
+}
@@ -0,0 +1,72 @@
+// Package layout locates the module and the per-year and per-day package
+// directories of this repository.
+package layout
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Layout locates a repository with one <year>/go tree of day packages per
+// event, next to the shared go tree.
+type Layout struct {
+	// Root is the repository root, which holds go.mod.
+	Root string
+	// Module is the module path declared in go.mod.
+	Module string
+}
+
+// Find returns the layout of the module containing dir, found by
+// walking up to the nearest go.mod.
+func Find(dir string) (Layout, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return Layout{}, err
+	}
+	for {
+		data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			module, err := modulePath(data)
+			if err != nil {
+				return Layout{}, err
+			}
+			return Layout{Root: dir, Module: module}, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return Layout{}, fmt.Errorf("failed to read go.mod: %w", err)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return Layout{}, errors.New("not inside a Go module")
+		}
+		dir = parent
+	}
+}
+
+// modulePath returns the module path declared in a go.mod file.
+func modulePath(gomod []byte) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(gomod))
+	for scanner.Scan() {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(rest), `"`), nil
+		}
+	}
+	return "", errors.New("go.mod has no module directive")
+}
+
+// YearDir returns the directory holding a year's day packages.
+func (l Layout) YearDir(year int) string {
+	return filepath.Join(l.Root, strconv.Itoa(year), "go")
+}
+
+// DayDir returns the directory of a day's package.
+func (l Layout) DayDir(year, day int) string {
+	return filepath.Join(l.YearDir(year), fmt.Sprintf("day%02d", day))
+}
@@ -0,0 +1,28 @@
+package layout
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/aoc\n\ngo 1.24\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	l := Layout{Root: root, Module: "example.com/aoc"}
+	dir := l.DayDir(2025, 1)
+	os.MkdirAll(dir, 0o755)
+
+	got, err := Find(dir)
+	if err != nil {
+		t.Fatalf("Find() unexpected error: %v", err)
+	}
+	if got != l {
+		t.Errorf("Find() = %+v; want %+v", got, l)
+	}
+	if want := filepath.Join(root, "2025", "go", "day01"); dir != want {
+		t.Errorf("DayDir(2025, 1) = %q; want %q", dir, want)
+	}
+}
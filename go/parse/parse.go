@@ -0,0 +1,170 @@
+// Package parse turns common Advent of Code input shapes into values.
+// Functions that take several lines report failures as *Error, which
+// carries the 1-based line number and, where known, the column.
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Error is a failure to parse one line of input.
+type Error struct {
+	// Line is the 1-based line number within the input.
+	Line int
+	// Column is the 1-based rune offset within the line, or 0 if unknown.
+	Column int
+	Err    error
+}
+
+func (e *Error) Error() string {
+	if e.Column > 0 {
+		return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+	}
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Lines applies fn to every line, stopping at the first error, which is
+// returned as an *Error.
+func Lines[T any](lines []string, fn func(line string) (T, error)) ([]T, error) {
+	return LinesAt(1, lines, fn)
+}
+
+// LinesAt is Lines for lines that start at line number first of the input,
+// such as a Block.
+func LinesAt[T any](first int, lines []string, fn func(line string) (T, error)) ([]T, error) {
+	values := make([]T, 0, len(lines))
+	for i, line := range lines {
+		value, err := fn(line)
+		if err != nil {
+			return values, &Error{Line: first + i, Err: err}
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// Int parses a single base 10 integer, ignoring surrounding space.
+func Int(s string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer %q", s)
+	}
+	return n, nil
+}
+
+// Ints parses the whitespace-separated integers on a line.
+func Ints(line string) ([]int, error) {
+	fields := strings.Fields(line)
+	ints := make([]int, len(fields))
+	for i, field := range fields {
+		n, err := Int(field)
+		if err != nil {
+			return nil, err
+		}
+		ints[i] = n
+	}
+	return ints, nil
+}
+
+var signedIntPattern = regexp.MustCompile(`-?\d+`)
+
+// SignedInts extracts every integer from arbitrary text, treating a '-'
+// directly before a digit as a sign, as in "p=-3,14 v=2,-1". It fails on
+// integers too large for an int.
+func SignedInts(text string) ([]int, error) {
+	matches := signedIntPattern.FindAllString(text, -1)
+	ints := make([]int, len(matches))
+	for i, match := range matches {
+		n, err := Int(match)
+		if err != nil {
+			return nil, err
+		}
+		ints[i] = n
+	}
+	return ints, nil
+}
+
+// Block is a run of non-blank lines.
+type Block struct {
+	// Line is the 1-based line number of the block's first line.
+	Line  int
+	Lines []string
+}
+
+// Blocks splits lines into blocks separated by one or more blank lines.
+func Blocks(lines []string) []Block {
+	var blocks []Block
+	var current *Block
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			current = nil
+			continue
+		}
+		if current == nil {
+			blocks = append(blocks, Block{Line: i + 1})
+			current = &blocks[len(blocks)-1]
+		}
+		current.Lines = append(current.Lines, line)
+	}
+	return blocks
+}
+
+// ErrReversedRange is returned for a range whose start is after its end.
+var ErrReversedRange = errors.New("range start after end")
+
+// Range parses an inclusive "a-b" range of non-negative integers.
+func Range(s string) (start, end int, err error) {
+	a, b, ok := strings.Cut(strings.TrimSpace(s), "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid range %q", s)
+	}
+	if start, err = Int(a); err != nil {
+		return 0, 0, fmt.Errorf("invalid range %q: %w", s, err)
+	}
+	if end, err = Int(b); err != nil {
+		return 0, 0, fmt.Errorf("invalid range %q: %w", s, err)
+	}
+	if start > end {
+		return 0, 0, fmt.Errorf("%w: %q", ErrReversedRange, s)
+	}
+	return start, end, nil
+}
+
+// Tuple parses comma-separated integers such as "162,817,812". If n is
+// positive the tuple must have exactly n elements.
+func Tuple(s string, n int) ([]int, error) {
+	fields := strings.Split(s, ",")
+	if n > 0 && len(fields) != n {
+		return nil, fmt.Errorf("expected %d values in %q, got %d", n, s, len(fields))
+	}
+	values := make([]int, len(fields))
+	for i, field := range fields {
+		value, err := Int(field)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// Grid parses lines into rows of runes. Every row must be as wide as the
+// first.
+func Grid(lines []string) ([][]rune, error) {
+	grid := make([][]rune, len(lines))
+	for i, line := range lines {
+		grid[i] = []rune(line)
+		if len(grid[i]) != len(grid[0]) {
+			return nil, &Error{Line: i + 1, Err: fmt.Errorf("row has %d columns, want %d", len(grid[i]), len(grid[0]))}
+		}
+	}
+	return grid, nil
+}
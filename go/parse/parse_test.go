@@ -0,0 +1,165 @@
+package parse
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		line        string
+		expected    []int
+		expectError bool
+	}{
+		{"123 328  51 64", []int{123, 328, 51, 64}, false},
+		{"  -4 7 ", []int{-4, 7}, false},
+		{"", []int{}, false},
+		{"1 x 3", nil, true},
+	}
+
+	for _, tt := range tests {
+		got, err := Ints(tt.line)
+		if (err != nil) != tt.expectError {
+			t.Errorf("Ints(%q) error = %v; want error %t", tt.line, err, tt.expectError)
+			continue
+		}
+		if !tt.expectError && !slices.Equal(got, tt.expected) {
+			t.Errorf("Ints(%q) = %v; want %v", tt.line, got, tt.expected)
+		}
+	}
+}
+
+func TestSignedInts(t *testing.T) {
+	tests := []struct {
+		text        string
+		expected    []int
+		expectError bool
+	}{
+		{"p=0,4 v=3,-3", []int{0, 4, 3, -3}, false},
+		{"Button A: X+94, Y+34", []int{94, 34}, false},
+		{"L68", []int{68}, false},
+		{"no numbers", []int{}, false},
+		{"x=-9223372036854775808", []int{-9223372036854775808}, false},
+		{"x=1 y=99999999999999999999", nil, true},
+	}
+
+	for _, tt := range tests {
+		got, err := SignedInts(tt.text)
+		if (err != nil) != tt.expectError {
+			t.Errorf("SignedInts(%q) error = %v; want error %t", tt.text, err, tt.expectError)
+			continue
+		}
+		if !tt.expectError && !slices.Equal(got, tt.expected) {
+			t.Errorf("SignedInts(%q) = %v; want %v", tt.text, got, tt.expected)
+		}
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	lines := []string{"3-5", "10-14", "", "", "1", "5", ""}
+	blocks := Blocks(lines)
+
+	if len(blocks) != 2 {
+		t.Fatalf("Blocks() = %d blocks; want 2", len(blocks))
+	}
+	if blocks[0].Line != 1 || !slices.Equal(blocks[0].Lines, []string{"3-5", "10-14"}) {
+		t.Errorf("Blocks()[0] = %+v; want lines 3-5, 10-14 from line 1", blocks[0])
+	}
+	if blocks[1].Line != 5 || !slices.Equal(blocks[1].Lines, []string{"1", "5"}) {
+		t.Errorf("Blocks()[1] = %+v; want lines 1, 5 from line 5", blocks[1])
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		input       string
+		start, end  int
+		expectError bool
+	}{
+		{"11-22", 11, 22, false},
+		{"291687894568177-292172488078380", 291687894568177, 292172488078380, false},
+		{"7-7", 7, 7, false},
+		{"invalid", 0, 0, true},
+		{"10-20-30", 0, 0, true},
+		{"abc-def", 0, 0, true},
+		{"20-10", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		start, end, err := Range(tt.input)
+		if (err != nil) != tt.expectError {
+			t.Errorf("Range(%q) error = %v; want error %t", tt.input, err, tt.expectError)
+			continue
+		}
+		if start != tt.start || end != tt.end {
+			t.Errorf("Range(%q) = %d, %d; want %d, %d", tt.input, start, end, tt.start, tt.end)
+		}
+	}
+
+	if _, _, err := Range("20-10"); !errors.Is(err, ErrReversedRange) {
+		t.Errorf("Range(\"20-10\") error = %v; want ErrReversedRange", err)
+	}
+}
+
+func TestTuple(t *testing.T) {
+	tests := []struct {
+		input       string
+		n           int
+		expected    []int
+		expectError bool
+	}{
+		{"162,817,812", 3, []int{162, 817, 812}, false},
+		{"1, 2", 0, []int{1, 2}, false},
+		{"1,2", 3, nil, true},
+		{"1,,2", 0, nil, true},
+	}
+
+	for _, tt := range tests {
+		got, err := Tuple(tt.input, tt.n)
+		if (err != nil) != tt.expectError {
+			t.Errorf("Tuple(%q, %d) error = %v; want error %t", tt.input, tt.n, err, tt.expectError)
+			continue
+		}
+		if !tt.expectError && !slices.Equal(got, tt.expected) {
+			t.Errorf("Tuple(%q, %d) = %v; want %v", tt.input, tt.n, got, tt.expected)
+		}
+	}
+}
+
+func TestGrid(t *testing.T) {
+	grid, err := Grid([]string{"..@", "@.."})
+	if err != nil {
+		t.Fatalf("Grid() unexpected error: %v", err)
+	}
+	if len(grid) != 2 || string(grid[1]) != "@.." {
+		t.Errorf("Grid() = %q; want two rows", grid)
+	}
+
+	_, err = Grid([]string{"...", "..", "..."})
+	var parseErr *Error
+	if !errors.As(err, &parseErr) || parseErr.Line != 2 {
+		t.Errorf("Grid() error = %v; want *Error on line 2", err)
+	}
+}
+
+func TestLinesReportsLineNumbers(t *testing.T) {
+	_, err := LinesAt(10, []string{"1,2,3", "4,5", "6,7,8"}, func(line string) ([]int, error) {
+		return Tuple(line, 3)
+	})
+
+	var parseErr *Error
+	if !errors.As(err, &parseErr) || parseErr.Line != 11 {
+		t.Fatalf("LinesAt() error = %v; want *Error on line 11", err)
+	}
+	if got := err.Error(); got != `line 11: expected 3 values in "4,5", got 2` {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestErrorColumn(t *testing.T) {
+	err := &Error{Line: 3, Column: 7, Err: errors.New("unexpected '-'")}
+	if got := err.Error(); got != "line 3, column 7: unexpected '-'" {
+		t.Errorf("Error() = %q", got)
+	}
+}
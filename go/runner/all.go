@@ -0,0 +1,232 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+// ErrTimeout is returned for a day that did not finish within its timeout.
+var ErrTimeout = errors.New("timed out")
+
+// PanicError is returned for a day whose solver panicked.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Options configures RunAll.
+type Options struct {
+	// Workers bounds how many days run at once. Zero means GOMAXPROCS.
+	Workers int
+	// Timeout bounds each day, including reading its input. Zero means none.
+	Timeout time.Duration
+	// Repeat is how many times each part is run, as for Run.
+	Repeat int
+	// Input returns the puzzle input for a day.
+	Input func(ctx context.Context, puzzle solver.Puzzle) ([]string, error)
+}
+
+// Result is the outcome of one day in RunAll.
+type Result struct {
+	Puzzle solver.Puzzle
+	// Report holds the parts solved before any error.
+	Report  Report
+	Elapsed time.Duration
+	// Err does not name the puzzle, which is in Puzzle.
+	Err error
+}
+
+// Summary is the outcome of RunAll.
+type Summary struct {
+	// Results are in the order the puzzles were given.
+	Results []Result
+	// Elapsed is the wall-clock time for the whole run.
+	Elapsed time.Duration
+}
+
+// Failed returns the results that ended in an error.
+func (s Summary) Failed() []Result {
+	var failed []Result
+	for _, result := range s.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// RunAll solves both parts of every puzzle on a bounded pool of workers.
+// A panicking day is recovered and a day that overruns its timeout is
+// abandoned, so neither stops the others; an abandoned solver keeps its
+// goroutine until it returns. Allocation figures are approximate because
+// concurrent days share the heap counters.
+func RunAll(ctx context.Context, puzzles []solver.Puzzle, opts Options) Summary {
+	workers := opts.Workers
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	start := time.Now()
+	results := make([]Result, len(puzzles))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = runDay(ctx, puzzles[i], opts)
+			}
+		}()
+	}
+	for i := range puzzles {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return Summary{Results: results, Elapsed: time.Since(start)}
+}
+
+// runDay reads the input for one puzzle and runs it under the timeout.
+func runDay(ctx context.Context, puzzle solver.Puzzle, opts Options) Result {
+	start := time.Now()
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	done := make(chan Result, 1)
+	go func() {
+		result := Result{Puzzle: puzzle}
+		defer func() {
+			if v := recover(); v != nil {
+				result.Err = &PanicError{Value: v, Stack: debug.Stack()}
+			}
+			done <- result
+		}()
+
+		input, err := opts.Input(ctx, puzzle)
+		if err != nil {
+			result.Err = fmt.Errorf("input: %w", err)
+			return
+		}
+		result.Report, result.Err = run(puzzle, input, []int{1, 2}, opts.Repeat)
+	}()
+
+	select {
+	case result := <-done:
+		result.Elapsed = time.Since(start)
+		return result
+	case <-ctx.Done():
+		err := ctx.Err()
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = fmt.Errorf("%w after %s", ErrTimeout, opts.Timeout)
+		}
+		return Result{Puzzle: puzzle, Elapsed: time.Since(start), Err: err}
+	}
+}
+
+// calendarCell is the width of one day in WriteCalendar.
+const calendarCell = 16
+
+// WriteCalendar writes the results as one December calendar per year, with
+// a cell per day showing its stars and runtime, followed by any failures
+// and the totals. Stars are "**" for both parts solved, "*" for one, "P"
+// for a panic, "T" for a timeout and "E" for another error.
+func WriteCalendar(w io.Writer, summary Summary) error {
+	byYear := map[int]map[int]Result{}
+	var years []int
+	for _, result := range summary.Results {
+		year := result.Puzzle.Year
+		if byYear[year] == nil {
+			byYear[year] = map[int]Result{}
+			years = append(years, year)
+		}
+		byYear[year][result.Puzzle.Day] = result
+	}
+
+	var b strings.Builder
+	stars := 0
+	var cpu time.Duration
+	for _, year := range years {
+		days := byYear[year]
+		last := 0
+		for day, result := range days {
+			last = max(last, day)
+			stars += len(result.Report.Parts)
+			cpu += result.Elapsed
+		}
+
+		fmt.Fprintf(&b, "December %d\n", year)
+		writeWeek(&b, []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"})
+
+		// Weeks start on Monday; pad up to the weekday of December 1st.
+		column := (int(time.Date(year, time.December, 1, 0, 0, 0, 0, time.UTC).Weekday()) + 6) % 7
+		week := make([]string, column)
+		for day := 1; day <= last; day++ {
+			week = append(week, calendarDay(day, days))
+			if len(week) == 7 {
+				writeWeek(&b, week)
+				week = week[:0]
+			}
+		}
+		if len(week) > 0 {
+			writeWeek(&b, week)
+		}
+		b.WriteString("\n")
+	}
+
+	for _, result := range summary.Failed() {
+		fmt.Fprintf(&b, "%s: %v\n", result.Puzzle, result.Err)
+	}
+	fmt.Fprintf(&b, "%d days, %d stars, %d failed in %s (%s across days)\n",
+		len(summary.Results), stars, len(summary.Failed()),
+		formatDuration(summary.Elapsed), formatDuration(cpu))
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeWeek writes one row of the calendar in fixed-width cells.
+func writeWeek(b *strings.Builder, cells []string) {
+	var row strings.Builder
+	for _, cell := range cells {
+		fmt.Fprintf(&row, "%-*s", calendarCell, cell)
+	}
+	b.WriteString(strings.TrimRight(row.String(), " ") + "\n")
+}
+
+// calendarDay formats one cell of the calendar.
+func calendarDay(day int, days map[int]Result) string {
+	result, ok := days[day]
+	if !ok {
+		return fmt.Sprintf("%2d", day)
+	}
+
+	mark := strings.Repeat("*", len(result.Report.Parts))
+	var panicErr *PanicError
+	switch {
+	case errors.As(result.Err, &panicErr):
+		mark = "P"
+	case errors.Is(result.Err, ErrTimeout):
+		mark = "T"
+	case result.Err != nil:
+		mark = "E"
+	}
+	return fmt.Sprintf("%2d %-2s %s", day, mark, formatDuration(result.Elapsed))
+}
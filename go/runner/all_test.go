@@ -0,0 +1,115 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+// panicker panics in Part1.
+type panicker struct{ lineCounter }
+
+func (panicker) Part1(input []string) (string, error) { panic("boom") }
+
+// blocker never returns until release is closed.
+type blocker struct {
+	lineCounter
+	release chan struct{}
+}
+
+func (b blocker) Part1(input []string) (string, error) {
+	<-b.release
+	return "", nil
+}
+
+func TestRunAll(t *testing.T) {
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	puzzles := []solver.Puzzle{
+		{Year: 2025, Day: 1, Solver: parsingCounter{}},
+		{Year: 2025, Day: 2, Solver: panicker{}},
+		{Year: 2025, Day: 3, Solver: blocker{release: release}},
+		{Year: 2025, Day: 4, Solver: lineCounter{}},
+		{Year: 2025, Day: 5, Solver: parsingCounter{}},
+	}
+	summary := RunAll(context.Background(), puzzles, Options{
+		Workers: 2,
+		Timeout: 50 * time.Millisecond,
+		Input: func(ctx context.Context, puzzle solver.Puzzle) ([]string, error) {
+			if puzzle.Day == 5 {
+				return nil, errors.New("no input")
+			}
+			return []string{"abc", "de"}, nil
+		},
+	})
+
+	if len(summary.Results) != len(puzzles) {
+		t.Fatalf("RunAll() returned %d results; want %d", len(summary.Results), len(puzzles))
+	}
+	for i, result := range summary.Results {
+		if result.Puzzle.Day != puzzles[i].Day {
+			t.Errorf("result %d is day %d; want day %d", i, result.Puzzle.Day, puzzles[i].Day)
+		}
+	}
+
+	if err := summary.Results[0].Err; err != nil {
+		t.Errorf("day 1 unexpected error: %v", err)
+	}
+	if got := len(summary.Results[0].Report.Parts); got != 2 {
+		t.Errorf("day 1 solved %d parts; want 2", got)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(summary.Results[1].Err, &panicErr) || panicErr.Value != "boom" {
+		t.Errorf("day 2 error = %v; want recovered panic", summary.Results[1].Err)
+	}
+	if !errors.Is(summary.Results[2].Err, ErrTimeout) {
+		t.Errorf("day 3 error = %v; want ErrTimeout", summary.Results[2].Err)
+	}
+	if got := len(summary.Results[3].Report.Parts); got != 1 || summary.Results[3].Err == nil {
+		t.Errorf("day 4 = %d parts, error %v; want 1 part and the part 2 error", got, summary.Results[3].Err)
+	}
+	if summary.Results[4].Err == nil {
+		t.Error("day 5 expected input error")
+	}
+	if got := len(summary.Failed()); got != 4 {
+		t.Errorf("Failed() = %d results; want 4", got)
+	}
+}
+
+func TestWriteCalendar(t *testing.T) {
+	summary := Summary{
+		Results: []Result{
+			{Puzzle: solver.Puzzle{Year: 2025, Day: 1}, Report: Report{Parts: make([]PartReport, 2)}, Elapsed: time.Millisecond},
+			{Puzzle: solver.Puzzle{Year: 2025, Day: 3}, Err: &PanicError{Value: "boom"}},
+			{Puzzle: solver.Puzzle{Year: 2025, Day: 8}, Err: ErrTimeout},
+		},
+		Elapsed: 2 * time.Millisecond,
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCalendar(&buf, summary); err != nil {
+		t.Fatalf("WriteCalendar() unexpected error: %v", err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+
+	// December 1st 2025 is a Monday, so day 8 starts the second week.
+	if !strings.HasPrefix(lines[2], " 1 **") {
+		t.Errorf("first week = %q; want it to start with day 1", lines[2])
+	}
+	if !strings.Contains(lines[2], " 3 P") {
+		t.Errorf("first week = %q; want day 3 marked as panicked", lines[2])
+	}
+	if !strings.HasPrefix(lines[3], " 8 T") {
+		t.Errorf("second week = %q; want it to start with day 8 timed out", lines[3])
+	}
+	if !strings.Contains(buf.String(), "3 days, 2 stars, 2 failed") {
+		t.Errorf("totals missing from:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,142 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strings"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+// Profiles names the profiles to record around a run. Each is a base file
+// name that ProfileName qualifies with the puzzle and part; an empty name
+// records nothing.
+type Profiles struct {
+	CPU   string
+	Mem   string
+	Trace string
+}
+
+// Enabled reports whether any profile is requested.
+func (p Profiles) Enabled() bool {
+	return p.CPU != "" || p.Mem != "" || p.Trace != ""
+}
+
+// ProfileName inserts the puzzle and part before the extension of base, so
+// "cpu.pprof" becomes "cpu-2025-day07-part1.pprof".
+func ProfileName(base string, puzzle solver.Puzzle, part int) string {
+	ext := filepath.Ext(base)
+	return fmt.Sprintf("%s-%d-day%02d-part%d%s", strings.TrimSuffix(base, ext), puzzle.Year, puzzle.Day, part, ext)
+}
+
+// BaseProfileName returns the name of the allocation profile taken before a
+// part ran, given the name of the one taken after it, so that
+// "mem-2025-day07-part1.pprof" has the base "mem-2025-day07-part1-base.pprof".
+func BaseProfileName(name string) string {
+	ext := filepath.Ext(name)
+	return strings.TrimSuffix(name, ext) + "-base" + ext
+}
+
+// Record runs fn while recording the requested profiles for one part and
+// returns the files written. The allocation profile is cumulative, so Record
+// writes one before fn runs too; go tool pprof -base, or TopFunctions, shows
+// what fn allocated. Lower MemProfileRate first to catch every allocation.
+func (p Profiles) Record(puzzle solver.Puzzle, part int, fn func() error) (written []string, err error) {
+	var files []*os.File
+	defer func() {
+		for _, file := range files {
+			if closeErr := file.Close(); closeErr != nil && err == nil {
+				err = fmt.Errorf("failed to write %s: %w", file.Name(), closeErr)
+			}
+		}
+	}()
+	create := func(name string) (*os.File, error) {
+		file, err := os.Create(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create profile: %w", err)
+		}
+		files = append(files, file)
+		written = append(written, name)
+		return file, nil
+	}
+
+	if p.CPU != "" {
+		file, err := create(ProfileName(p.CPU, puzzle, part))
+		if err != nil {
+			return written, err
+		}
+		if err := pprof.StartCPUProfile(file); err != nil {
+			return written, fmt.Errorf("failed to start CPU profile: %w", err)
+		}
+		defer pprof.StopCPUProfile()
+	}
+
+	if p.Trace != "" {
+		file, err := create(ProfileName(p.Trace, puzzle, part))
+		if err != nil {
+			return written, err
+		}
+		if err := trace.Start(file); err != nil {
+			return written, fmt.Errorf("failed to start trace: %w", err)
+		}
+		defer trace.Stop()
+	}
+
+	mem := ProfileName(p.Mem, puzzle, part)
+	if p.Mem != "" {
+		file, err := create(BaseProfileName(mem))
+		if err != nil {
+			return written, err
+		}
+		if err := writeAllocs(file); err != nil {
+			return written, err
+		}
+	}
+
+	if err := fn(); err != nil {
+		return written, err
+	}
+
+	if p.Mem != "" {
+		file, err := create(mem)
+		if err != nil {
+			return written, err
+		}
+		if err := writeAllocs(file); err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// writeAllocs writes the allocation profile to w. It collects garbage
+// first, as the runtime only publishes the profile after a cycle.
+func writeAllocs(w io.Writer) error {
+	runtime.GC()
+	if err := pprof.Lookup("allocs").WriteTo(w, 0); err != nil {
+		return fmt.Errorf("failed to write memory profile: %w", err)
+	}
+	return nil
+}
+
+// TopFunctions returns the n hottest functions in a CPU or memory profile,
+// as listed by go tool pprof -top. A non-empty base is subtracted from the
+// profile first.
+func TopFunctions(ctx context.Context, profile, base string, n int) (string, error) {
+	args := []string{"tool", "pprof", "-top", fmt.Sprintf("-nodecount=%d", n)}
+	if base != "" {
+		args = append(args, "-base", base)
+	}
+	out, err := exec.CommandContext(ctx, "go", append(args, profile)...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("go tool pprof %s: %w: %s", profile, err, strings.TrimSpace(string(out)))
+	}
+	return string(out), nil
+}
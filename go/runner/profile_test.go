@@ -0,0 +1,115 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func TestProfileName(t *testing.T) {
+	puzzle := solver.Puzzle{Year: 2025, Day: 7}
+	tests := []struct {
+		base     string
+		part     int
+		expected string
+	}{
+		{"cpu.pprof", 1, "cpu-2025-day07-part1.pprof"},
+		{"out/mem.prof", 2, "out/mem-2025-day07-part2.prof"},
+		{"trace", 1, "trace-2025-day07-part1"},
+	}
+
+	for _, tt := range tests {
+		if got := ProfileName(tt.base, puzzle, tt.part); got != tt.expected {
+			t.Errorf("ProfileName(%q, %d) = %q; want %q", tt.base, tt.part, got, tt.expected)
+		}
+	}
+}
+
+func TestBaseProfileName(t *testing.T) {
+	tests := []struct{ name, expected string }{
+		{"mem-2025-day07-part1.pprof", "mem-2025-day07-part1-base.pprof"},
+		{"out/mem-2025-day07-part2", "out/mem-2025-day07-part2-base"},
+	}
+
+	for _, tt := range tests {
+		if got := BaseProfileName(tt.name); got != tt.expected {
+			t.Errorf("BaseProfileName(%q) = %q; want %q", tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestRecord(t *testing.T) {
+	dir := t.TempDir()
+	profiles := Profiles{
+		CPU:   filepath.Join(dir, "cpu.pprof"),
+		Mem:   filepath.Join(dir, "mem.pprof"),
+		Trace: filepath.Join(dir, "trace.out"),
+	}
+	puzzle := solver.Puzzle{Year: 2025, Day: 1, Solver: lineCounter{}}
+
+	ran := false
+	written, err := profiles.Record(puzzle, 1, func() error {
+		ran = true
+		_, err := Run(puzzle, []string{"a"}, []int{1}, 1)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Record() unexpected error: %v", err)
+	}
+	if !ran {
+		t.Error("Record() did not run fn")
+	}
+	if len(written) != 4 {
+		t.Fatalf("Record() wrote %v; want 4 files", written)
+	}
+	for _, name := range written {
+		info, err := os.Stat(name)
+		if err != nil || info.Size() == 0 {
+			t.Errorf("profile %s missing or empty: %v", name, err)
+		}
+	}
+}
+
+var sink [][]byte
+
+//go:noinline
+func allocateBeforeRecord() { sink = append(sink, make([]byte, 1<<20)) }
+
+//go:noinline
+func allocateDuringRecord() { sink = append(sink, make([]byte, 1<<20)) }
+
+func TestRecordMemIsPerPart(t *testing.T) {
+	defer func(rate int) { runtime.MemProfileRate = rate }(runtime.MemProfileRate)
+	runtime.MemProfileRate = 1
+
+	allocateBeforeRecord()
+	profiles := Profiles{Mem: filepath.Join(t.TempDir(), "mem.pprof")}
+	written, err := profiles.Record(solver.Puzzle{Year: 2025, Day: 1}, 2, func() error {
+		allocateDuringRecord()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Record() unexpected error: %v", err)
+	}
+
+	name := ProfileName(profiles.Mem, solver.Puzzle{Year: 2025, Day: 1}, 2)
+	if !slices.Equal(written, []string{BaseProfileName(name), name}) {
+		t.Fatalf("Record() wrote %v; want the base and final profiles", written)
+	}
+	top, err := TopFunctions(context.Background(), name, BaseProfileName(name), 20)
+	if err != nil {
+		t.Skipf("go tool pprof unavailable: %v", err)
+	}
+	if !strings.Contains(top, "allocateDuringRecord") {
+		t.Errorf("memory profile misses allocations made by fn:\n%s", top)
+	}
+	if strings.Contains(top, "allocateBeforeRecord") {
+		t.Errorf("memory profile holds allocations made before fn:\n%s", top)
+	}
+}
@@ -0,0 +1,213 @@
+// Package runner runs registered solvers and measures how long parsing and
+// solving take and how much they allocate.
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+// Stats summarises repeated measurements of one phase.
+type Stats struct {
+	Min    time.Duration `json:"min_ns"`
+	Median time.Duration `json:"median_ns"`
+	Max    time.Duration `json:"max_ns"`
+	// Allocs and Bytes are the mean heap allocations per run.
+	Allocs uint64 `json:"allocs"`
+	Bytes  uint64 `json:"bytes"`
+}
+
+// PartReport is the answer and measurements for one part.
+type PartReport struct {
+	Part   int    `json:"part"`
+	Answer string `json:"answer"`
+	// Parse is nil for solvers that do not implement solver.Parser.
+	Parse *Stats `json:"parse,omitempty"`
+	Solve Stats  `json:"solve"`
+}
+
+// Report collects the part reports for one puzzle.
+type Report struct {
+	Year   int          `json:"year"`
+	Day    int          `json:"day"`
+	Repeat int          `json:"repeat"`
+	Parts  []PartReport `json:"parts"`
+}
+
+// sample is a single measured run.
+type sample struct {
+	elapsed       time.Duration
+	allocs, bytes uint64
+}
+
+// Run solves the given parts of a puzzle repeat times each and reports the
+// answers with timing and allocation statistics. Solvers implementing
+// solver.Parser are parsed afresh on every run, with parsing timed apart.
+func Run(puzzle solver.Puzzle, input []string, parts []int, repeat int) (Report, error) {
+	report, err := run(puzzle, input, parts, repeat)
+	if err != nil {
+		return report, fmt.Errorf("%s %w", puzzle, err)
+	}
+	return report, nil
+}
+
+// run is Run without the puzzle named in its errors.
+func run(puzzle solver.Puzzle, input []string, parts []int, repeat int) (Report, error) {
+	if repeat < 1 {
+		repeat = 1
+	}
+	report := Report{Year: puzzle.Year, Day: puzzle.Day, Repeat: repeat}
+
+	for _, part := range parts {
+		partReport, err := runPart(puzzle, input, part, repeat)
+		if err != nil {
+			return report, fmt.Errorf("part %d: %w", part, err)
+		}
+		report.Parts = append(report.Parts, partReport)
+	}
+	return report, nil
+}
+
+func runPart(puzzle solver.Puzzle, input []string, part, repeat int) (PartReport, error) {
+	parser, canParse := puzzle.Solver.(solver.Parser)
+	var parses, solves []sample
+	var answer string
+
+	for i := 0; i < repeat; i++ {
+		var err error
+		if !canParse {
+			solve := puzzle.Part(part)
+			solves = append(solves, measure(func() { answer, err = solve(input) }))
+			if err != nil {
+				return PartReport{}, err
+			}
+			continue
+		}
+
+		var parsed solver.Parsed
+		parses = append(parses, measure(func() { parsed, err = parser.Parse(input) }))
+		if err != nil {
+			return PartReport{}, err
+		}
+		solve := parsed.Part1
+		if part == 2 {
+			solve = parsed.Part2
+		}
+		solves = append(solves, measure(func() { answer, err = solve() }))
+		if err != nil {
+			return PartReport{}, err
+		}
+	}
+
+	report := PartReport{Part: part, Answer: answer, Solve: summarise(solves)}
+	if canParse {
+		stats := summarise(parses)
+		report.Parse = &stats
+	}
+	return report, nil
+}
+
+// measure times fn and counts the heap allocations it makes.
+func measure(fn func()) sample {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	fn()
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	return sample{
+		elapsed: elapsed,
+		allocs:  after.Mallocs - before.Mallocs,
+		bytes:   after.TotalAlloc - before.TotalAlloc,
+	}
+}
+
+func summarise(samples []sample) Stats {
+	durations := make([]time.Duration, len(samples))
+	var allocs, bytes uint64
+	for i, s := range samples {
+		durations[i] = s.elapsed
+		allocs += s.allocs
+		bytes += s.bytes
+	}
+	slices.Sort(durations)
+
+	n := uint64(len(samples))
+	return Stats{
+		Min:    durations[0],
+		Median: durations[len(durations)/2],
+		Max:    durations[len(durations)-1],
+		Allocs: allocs / n,
+		Bytes:  bytes / n,
+	}
+}
+
+// WriteJSON writes the reports as an indented JSON array.
+func WriteJSON(w io.Writer, reports []Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(reports)
+}
+
+// WriteTable writes the reports as an aligned table. Durations are shown as
+// min/median/max when runs were repeated.
+func WriteTable(w io.Writer, reports []Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "YEAR\tDAY\tPART\tANSWER\tPARSE\tSOLVE\tALLOCS\tBYTES\t")
+	for _, report := range reports {
+		for _, part := range report.Parts {
+			parse, allocs, bytes := "-", part.Solve.Allocs, part.Solve.Bytes
+			if part.Parse != nil {
+				parse = formatStats(*part.Parse, report.Repeat)
+				allocs += part.Parse.Allocs
+				bytes += part.Parse.Bytes
+			}
+			fmt.Fprintf(tw, "%d\t%d\t%d\t%s\t%s\t%s\t%d\t%s\t\n",
+				report.Year, report.Day, part.Part, part.Answer,
+				parse, formatStats(part.Solve, report.Repeat), allocs, formatBytes(bytes))
+		}
+	}
+	return tw.Flush()
+}
+
+func formatStats(s Stats, repeat int) string {
+	if repeat == 1 {
+		return formatDuration(s.Median)
+	}
+	return strings.Join([]string{formatDuration(s.Min), formatDuration(s.Median), formatDuration(s.Max)}, " / ")
+}
+
+// formatDuration rounds a duration to three significant figures.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(time.Microsecond).String()
+	case d >= time.Microsecond:
+		return d.Round(time.Microsecond / 10).String()
+	}
+	return d.String()
+}
+
+func formatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,134 @@
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+// lineCounter answers with the number of input lines.
+type lineCounter struct{}
+
+func (lineCounter) Part1(input []string) (string, error) { return strconv.Itoa(len(input)), nil }
+func (lineCounter) Part2(input []string) (string, error) { return "", errors.New("unsolved") }
+
+// parsingCounter answers with the total length of the input lines.
+type parsingCounter struct{}
+
+type parsedTotal int
+
+func (parsingCounter) Parse(input []string) (solver.Parsed, error) {
+	total := 0
+	for _, line := range input {
+		total += len(line)
+	}
+	return parsedTotal(total), nil
+}
+
+func (c parsingCounter) Part1(input []string) (string, error) {
+	parsed, _ := c.Parse(input)
+	return parsed.Part1()
+}
+
+func (c parsingCounter) Part2(input []string) (string, error) {
+	parsed, _ := c.Parse(input)
+	return parsed.Part2()
+}
+
+func (p parsedTotal) Part1() (string, error) { return strconv.Itoa(int(p)), nil }
+func (p parsedTotal) Part2() (string, error) { return strconv.Itoa(int(p) * 2), nil }
+
+func TestRun(t *testing.T) {
+	input := []string{"abc", "de"}
+
+	report, err := Run(solver.Puzzle{Year: 2025, Day: 1, Solver: lineCounter{}}, input, []int{1}, 1)
+	if err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if len(report.Parts) != 1 || report.Parts[0].Answer != "2" {
+		t.Fatalf("Run() parts = %+v; want one part answering 2", report.Parts)
+	}
+	if report.Parts[0].Parse != nil {
+		t.Error("Run() reported parse stats for a solver without Parse")
+	}
+
+	report, err = Run(solver.Puzzle{Year: 2025, Day: 2, Solver: parsingCounter{}}, input, []int{1, 2}, 5)
+	if err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if report.Parts[0].Answer != "5" || report.Parts[1].Answer != "10" {
+		t.Errorf("Run() answers = %s, %s; want 5, 10", report.Parts[0].Answer, report.Parts[1].Answer)
+	}
+	for _, part := range report.Parts {
+		if part.Parse == nil {
+			t.Fatalf("part %d has no parse stats", part.Part)
+		}
+		s := part.Solve
+		if s.Min > s.Median || s.Median > s.Max {
+			t.Errorf("part %d stats out of order: %v / %v / %v", part.Part, s.Min, s.Median, s.Max)
+		}
+	}
+}
+
+func TestRunError(t *testing.T) {
+	_, err := Run(solver.Puzzle{Year: 2025, Day: 1, Solver: lineCounter{}}, nil, []int{1, 2}, 1)
+	if err == nil || !strings.Contains(err.Error(), "part 2") {
+		t.Errorf("Run() error = %v; want part 2 failure", err)
+	}
+}
+
+func TestWriteTableAndJSON(t *testing.T) {
+	// Fixed stats keep the table independent of the clock; the µs and ns
+	// units differ in bytes but not in runes.
+	report := Report{Year: 2025, Day: 2, Repeat: 3, Parts: []PartReport{
+		{
+			Part:   1,
+			Answer: "3",
+			Parse:  &Stats{Min: 800, Median: 900, Max: 950, Allocs: 1, Bytes: 16},
+			Solve:  Stats{Min: 1200, Median: 1500, Max: 2 * time.Microsecond, Allocs: 2, Bytes: 2048},
+		},
+		{
+			Part:   2,
+			Answer: "6",
+			Solve:  Stats{Min: 40, Median: 50, Max: 60},
+		},
+	}}
+
+	var table bytes.Buffer
+	if err := WriteTable(&table, []Report{report}); err != nil {
+		t.Fatalf("WriteTable() unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimRight(table.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("WriteTable() wrote %d lines; want 3:\n%s", len(lines), table.String())
+	}
+	width := utf8.RuneCountInString(lines[0])
+	for _, line := range lines[1:] {
+		if utf8.RuneCountInString(line) != width {
+			t.Errorf("WriteTable() rows are not aligned:\n%s", table.String())
+			break
+		}
+	}
+	if !strings.Contains(lines[1], "800ns / 900ns / 950ns") || !strings.Contains(lines[1], "1.2µs / 1.5µs / 2µs") {
+		t.Errorf("WriteTable() part 1 row = %q; want the parse and solve stats", lines[1])
+	}
+
+	var out bytes.Buffer
+	if err := WriteJSON(&out, []Report{report}); err != nil {
+		t.Fatalf("WriteJSON() unexpected error: %v", err)
+	}
+	var decoded []Report
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatalf("WriteJSON() wrote invalid JSON: %v", err)
+	}
+	if decoded[0].Parts[1].Answer != "6" || decoded[0].Parts[0].Solve.Median != 1500 {
+		t.Errorf("decoded report = %+v; want %+v", decoded[0], report)
+	}
+}
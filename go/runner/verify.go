@@ -0,0 +1,81 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+// Status is the outcome of checking one part against its expected answer.
+type Status string
+
+const (
+	Pass    Status = "pass"
+	Fail    Status = "FAIL"
+	Errored Status = "ERROR"
+	// Unknown means no expected answer is recorded for the part.
+	Unknown Status = "new"
+	// Skipped means the puzzle input is not available.
+	Skipped Status = "skip"
+)
+
+// Check is the result of verifying one part.
+type Check struct {
+	Year, Day, Part int
+	Status          Status
+	Expected, Got   string
+	Err             error
+}
+
+// Verify solves both parts of a puzzle and compares the answers with the
+// expected ones, which the lookup returns when recorded.
+func Verify(puzzle solver.Puzzle, input []string, expected func(part int) (string, bool)) []Check {
+	checks := make([]Check, 0, 2)
+	for part := 1; part <= 2; part++ {
+		check := Check{Year: puzzle.Year, Day: puzzle.Day, Part: part}
+		want, known := expected(part)
+		check.Expected = want
+
+		got, err := puzzle.Part(part)(input)
+		switch {
+		case err != nil:
+			check.Status, check.Err = Errored, err
+		case !known:
+			check.Status = Unknown
+		case got == want:
+			check.Status = Pass
+		default:
+			check.Status = Fail
+		}
+		check.Got = got
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// Failed reports whether any check failed or errored.
+func Failed(checks []Check) bool {
+	for _, check := range checks {
+		if check.Status == Fail || check.Status == Errored {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteChecks writes the checks as an aligned table, showing expected and
+// actual answers side by side for failures.
+func WriteChecks(w io.Writer, checks []Check) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "YEAR\tDAY\tPART\tSTATUS\tEXPECTED\tGOT")
+	for _, c := range checks {
+		got := c.Got
+		if c.Err != nil {
+			got = c.Err.Error()
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%s\t%s\t%s\n", c.Year, c.Day, c.Part, c.Status, c.Expected, got)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,59 @@
+package runner
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/solver"
+)
+
+func TestVerify(t *testing.T) {
+	puzzle := solver.Puzzle{Year: 2025, Day: 2, Solver: parsingCounter{}}
+	input := []string{"abc"}
+
+	tests := []struct {
+		name     string
+		expected map[int]string
+		statuses [2]Status
+	}{
+		{"All pass", map[int]string{1: "3", 2: "6"}, [2]Status{Pass, Pass}},
+		{"Regression", map[int]string{1: "3", 2: "7"}, [2]Status{Pass, Fail}},
+		{"Unrecorded", map[int]string{1: "3"}, [2]Status{Pass, Unknown}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks := Verify(puzzle, input, func(part int) (string, bool) {
+				answer, ok := tt.expected[part]
+				return answer, ok
+			})
+			for i, check := range checks {
+				if check.Status != tt.statuses[i] {
+					t.Errorf("part %d status = %s; want %s", check.Part, check.Status, tt.statuses[i])
+				}
+			}
+			if Failed(checks) != (tt.statuses[1] == Fail) {
+				t.Errorf("Failed() = %v", Failed(checks))
+			}
+		})
+	}
+}
+
+func TestVerifyError(t *testing.T) {
+	checks := Verify(solver.Puzzle{Year: 2025, Day: 1, Solver: lineCounter{}}, nil, func(int) (string, bool) {
+		return "0", true
+	})
+	if checks[0].Status != Pass || checks[1].Status != Errored {
+		t.Fatalf("statuses = %s, %s; want pass, ERROR", checks[0].Status, checks[1].Status)
+	}
+	if !Failed(checks) {
+		t.Error("Failed() = false; want true for an erroring part")
+	}
+
+	var out bytes.Buffer
+	WriteChecks(&out, checks)
+	if !strings.Contains(out.String(), "unsolved") {
+		t.Errorf("WriteChecks() does not show the error:\n%s", out.String())
+	}
+}
@@ -0,0 +1,187 @@
+// Package scaffold generates the files for a new day from templates.
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"text/template"
+
+	"github.com/manning0218/adventOfCode/go/layout"
+)
+
+// ErrExists is returned when a file to generate already exists.
+var ErrExists = errors.New("file already exists")
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+var (
+	dayDirPattern  = regexp.MustCompile(`^day\d{2}$`)
+	yearDirPattern = regexp.MustCompile(`^\d{4}$`)
+)
+
+// Day describes the day being generated.
+type Day struct {
+	Module    string
+	Year, Day int
+	Package   string
+}
+
+// files maps each generated file, relative to the day directory, to its template.
+var files = map[string]string{
+	"solver.go":            "solver.go.tmpl",
+	"solver_test.go":       "solver_test.go.tmpl",
+	"solver_bench_test.go": "solver_bench_test.go.tmpl",
+}
+
+// NewDay writes the package, solver stub, test and benchmark for a day and
+// regenerates the registries for its year and for all years. It refuses to
+// overwrite any existing file and returns the paths it wrote.
+func NewDay(l layout.Layout, year, day int) ([]string, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("invalid day %d", day)
+	}
+	data := Day{Module: l.Module, Year: year, Day: day, Package: fmt.Sprintf("day%02d", day)}
+	dir := l.DayDir(year, day)
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Check everything up front so a refusal leaves no partial package
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return nil, fmt.Errorf("%w: %s", ErrExists, path)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "testdata"), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	var written []string
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if err := render(path, files[name], data, false); err != nil {
+			return written, err
+		}
+		written = append(written, path)
+	}
+
+	registry, err := WriteRegistry(l, year)
+	if err != nil {
+		return written, err
+	}
+	written = append(written, registry)
+
+	years, err := WriteYears(l)
+	if err != nil {
+		return written, err
+	}
+	return append(written, years), nil
+}
+
+// WriteRegistry regenerates <year>/go/days/days.go so it imports every dayNN
+// package of the year that has a solver.go, and returns its path.
+func WriteRegistry(l layout.Layout, year int) (string, error) {
+	dir := l.YearDir(year)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to list %s: %w", dir, err)
+	}
+
+	var packages []string
+	for _, entry := range entries {
+		if !entry.IsDir() || !dayDirPattern.MatchString(entry.Name()) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, entry.Name(), "solver.go")); err == nil {
+			packages = append(packages, entry.Name())
+		}
+	}
+
+	path := filepath.Join(dir, "days", "days.go")
+	data := struct {
+		Module   string
+		Year     int
+		Packages []string
+	}{l.Module, year, packages}
+	return path, writeGenerated(path, "days.go.tmpl", data)
+}
+
+// WriteYears regenerates go/years/years.go so it imports the days package
+// of every year, and returns its path.
+func WriteYears(l layout.Layout) (string, error) {
+	entries, err := os.ReadDir(l.Root)
+	if err != nil {
+		return "", fmt.Errorf("failed to list %s: %w", l.Root, err)
+	}
+
+	var years []string
+	for _, entry := range entries {
+		if !entry.IsDir() || !yearDirPattern.MatchString(entry.Name()) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(l.Root, entry.Name(), "go", "days", "days.go")); err == nil {
+			years = append(years, entry.Name())
+		}
+	}
+
+	path := filepath.Join(l.Root, "go", "years", "years.go")
+	data := struct {
+		Module string
+		Years  []string
+	}{l.Module, years}
+	return path, writeGenerated(path, "years.go.tmpl", data)
+}
+
+// writeGenerated renders a generated file, replacing any previous version.
+func writeGenerated(path, name string, data any) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	return render(path, name, data, true)
+}
+
+// render executes a template, formats the result as Go source and writes
+// it to path. Unless overwrite is set the file must not already exist.
+func render(path, name string, data any, overwrite bool) error {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return fmt.Errorf("failed to render %s: %w", name, err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", name, err)
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	}
+	file, err := os.OpenFile(path, flags, 0o644)
+	if errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("%w: %s", ErrExists, path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if _, err := file.Write(src); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return file.Close()
+}
@@ -0,0 +1,95 @@
+package scaffold
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/layout"
+)
+
+const testModule = "example.com/aoc"
+
+// newLayout returns a layout in a temporary directory with a go.mod.
+func newLayout(t *testing.T) layout.Layout {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module "+testModule+"\n\ngo 1.24\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return layout.Layout{Root: root, Module: testModule}
+}
+
+func TestNewDay(t *testing.T) {
+	l := newLayout(t)
+	os.MkdirAll(l.DayDir(2025, 1), 0o755)
+	os.WriteFile(filepath.Join(l.DayDir(2025, 1), "solver.go"), []byte("package day01\n"), 0o644)
+
+	written, err := NewDay(l, 2025, 9)
+	if err != nil {
+		t.Fatalf("NewDay() unexpected error: %v", err)
+	}
+	if len(written) != 5 {
+		t.Errorf("NewDay() wrote %d files; want 5: %v", len(written), written)
+	}
+
+	solver, err := os.ReadFile(filepath.Join(l.DayDir(2025, 9), "solver.go"))
+	if err != nil {
+		t.Fatalf("solver.go not written: %v", err)
+	}
+	for _, want := range []string{"solver.Register(2025, 9, Solver{})", `"` + testModule + `/go/solver"`} {
+		if !strings.Contains(string(solver), want) {
+			t.Errorf("solver.go does not contain %s:\n%s", want, solver)
+		}
+	}
+
+	registry, err := os.ReadFile(filepath.Join(l.YearDir(2025), "days", "days.go"))
+	if err != nil {
+		t.Fatalf("days.go not written: %v", err)
+	}
+	for _, pkg := range []string{"/2025/go/day01\"", "/2025/go/day09\""} {
+		if !strings.Contains(string(registry), pkg) {
+			t.Errorf("days.go does not import %s:\n%s", pkg, registry)
+		}
+	}
+}
+
+func TestNewDayForNewYear(t *testing.T) {
+	l := newLayout(t)
+	if _, err := NewDay(l, 2025, 1); err != nil {
+		t.Fatalf("NewDay(2025) unexpected error: %v", err)
+	}
+	if _, err := NewDay(l, 2019, 1); err != nil {
+		t.Fatalf("NewDay(2019) unexpected error: %v", err)
+	}
+
+	years, err := os.ReadFile(filepath.Join(l.Root, "go", "years", "years.go"))
+	if err != nil {
+		t.Fatalf("years.go not written: %v", err)
+	}
+	for _, pkg := range []string{testModule + "/2019/go/days\"", testModule + "/2025/go/days\""} {
+		if !strings.Contains(string(years), pkg) {
+			t.Errorf("years.go does not import %s:\n%s", pkg, years)
+		}
+	}
+}
+
+func TestNewDayRefusesToOverwrite(t *testing.T) {
+	l := newLayout(t)
+	os.MkdirAll(l.DayDir(2025, 3), 0o755)
+	existing := filepath.Join(l.DayDir(2025, 3), "solver_test.go")
+	os.WriteFile(existing, []byte("package day03\n"), 0o644)
+
+	_, err := NewDay(l, 2025, 3)
+	if !errors.Is(err, ErrExists) {
+		t.Fatalf("NewDay() error = %v; want ErrExists", err)
+	}
+	if _, err := os.Stat(filepath.Join(l.DayDir(2025, 3), "solver.go")); err == nil {
+		t.Error("NewDay() wrote solver.go despite refusing")
+	}
+	if data, _ := os.ReadFile(existing); string(data) != "package day03\n" {
+		t.Errorf("existing file was modified: %q", data)
+	}
+}
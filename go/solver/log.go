@@ -0,0 +1,39 @@
+package solver
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LevelTrace is the slog level solvers use for per-step output, one step
+// more verbose than slog.LevelDebug.
+const LevelTrace = slog.LevelDebug - 4
+
+// Logged is implemented by solvers that write debug and trace output.
+// Solvers log nothing until given a logger.
+type Logged interface {
+	// WithLogger returns a copy of the solver that logs to l.
+	WithLogger(l *slog.Logger) Solver
+}
+
+// WithLogger returns p with its solver logging to l, if it logs at all.
+func (p Puzzle) WithLogger(l *slog.Logger) Puzzle {
+	if s, ok := p.Solver.(Logged); ok {
+		p.Solver = s.WithLogger(l)
+	}
+	return p
+}
+
+// Tracing reports whether l, which may be nil, logs at LevelTrace. Callers
+// check it before calling Trace so that tracing costs nothing in hot loops
+// when it is off.
+func Tracing(l *slog.Logger) bool {
+	return l != nil && l.Enabled(context.Background(), LevelTrace)
+}
+
+// Trace logs a per-step message to l at LevelTrace. A nil l logs nothing.
+func Trace(l *slog.Logger, msg string, args ...any) {
+	if l != nil {
+		l.Log(context.Background(), LevelTrace, msg, args...)
+	}
+}
@@ -0,0 +1,119 @@
+// Package solver defines the interface every day's solution implements and
+// a registry of solutions keyed by year and day.
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrNotImplemented is returned by the parts of a freshly generated solver.
+var ErrNotImplemented = errors.New("not implemented")
+
+// Solver solves both parts of one day's puzzle.
+type Solver interface {
+	Part1(input []string) (string, error)
+	Part2(input []string) (string, error)
+}
+
+// Puzzle is a registered solver together with the day it solves.
+type Puzzle struct {
+	Year, Day int
+	Solver    Solver
+}
+
+// Part returns the function solving the given part (1 or 2).
+func (p Puzzle) Part(part int) func(input []string) (string, error) {
+	if part == 1 {
+		return p.Solver.Part1
+	}
+	return p.Solver.Part2
+}
+
+func (p Puzzle) String() string {
+	return fmt.Sprintf("%d day %02d", p.Year, p.Day)
+}
+
+type key struct{ year, day int }
+
+var (
+	mu       sync.RWMutex
+	registry = map[key]Solver{}
+)
+
+// Register adds the solver for a year and day. It panics if the day is
+// already registered, as that can only be a programming error.
+func Register(year, day int, s Solver) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	k := key{year, day}
+	if _, dup := registry[k]; dup {
+		panic(fmt.Sprintf("solver: %d day %d registered twice", year, day))
+	}
+	registry[k] = s
+}
+
+// Lookup returns the puzzle registered for a year and day.
+func Lookup(year, day int) (Puzzle, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	s, ok := registry[key{year, day}]
+	return Puzzle{Year: year, Day: day, Solver: s}, ok
+}
+
+// All returns every registered puzzle ordered by year and day.
+func All() []Puzzle {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	puzzles := make([]Puzzle, 0, len(registry))
+	for k, s := range registry {
+		puzzles = append(puzzles, Puzzle{Year: k.year, Day: k.day, Solver: s})
+	}
+	sort.Slice(puzzles, func(i, j int) bool {
+		if puzzles[i].Year != puzzles[j].Year {
+			return puzzles[i].Year < puzzles[j].Year
+		}
+		return puzzles[i].Day < puzzles[j].Day
+	})
+	return puzzles
+}
+
+// Year returns the puzzles registered for one year, ordered by day.
+func Year(year int) []Puzzle {
+	var puzzles []Puzzle
+	for _, puzzle := range All() {
+		if puzzle.Year == year {
+			puzzles = append(puzzles, puzzle)
+		}
+	}
+	return puzzles
+}
+
+// Years returns every year with a registered puzzle, in order.
+func Years() []int {
+	var years []int
+	for _, puzzle := range All() {
+		if len(years) == 0 || years[len(years)-1] != puzzle.Year {
+			years = append(years, puzzle.Year)
+		}
+	}
+	return years
+}
+
+// Parser is implemented by solvers whose parts share a parsed form of the
+// input, which lets the runner time parsing separately from solving.
+type Parser interface {
+	Parse(input []string) (Parsed, error)
+}
+
+// Parsed solves both parts from an input that has already been parsed.
+// Each call to Parse must return a fresh value, as parts may mutate it.
+type Parsed interface {
+	Part1() (string, error)
+	Part2() (string, error)
+}
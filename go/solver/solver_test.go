@@ -0,0 +1,125 @@
+package solver
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+type constSolver string
+
+func (c constSolver) Part1([]string) (string, error) { return string(c) + "1", nil }
+func (c constSolver) Part2([]string) (string, error) { return string(c) + "2", nil }
+
+func TestRegistry(t *testing.T) {
+	Register(1999, 2, constSolver("b"))
+	Register(1999, 1, constSolver("a"))
+	Register(1998, 25, constSolver("z"))
+
+	puzzle, ok := Lookup(1999, 1)
+	if !ok {
+		t.Fatal("Lookup(1999, 1) not found")
+	}
+	if answer, _ := puzzle.Part(2)(nil); answer != "a2" {
+		t.Errorf("Part(2) = %q; want a2", answer)
+	}
+	if _, ok := Lookup(1999, 3); ok {
+		t.Error("Lookup(1999, 3) found an unregistered day")
+	}
+
+	var got []string
+	for _, p := range All() {
+		if p.Year < 2000 {
+			got = append(got, p.String())
+		}
+	}
+	expected := []string{"1998 day 25", "1999 day 01", "1999 day 02"}
+	if len(got) != len(expected) {
+		t.Fatalf("All() = %v; want %v", got, expected)
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Errorf("All()[%d] = %s; want %s", i, got[i], expected[i])
+		}
+	}
+}
+
+func TestYear(t *testing.T) {
+	Register(1996, 3, constSolver("c"))
+	Register(1996, 1, constSolver("a"))
+	Register(1995, 1, constSolver("z"))
+
+	puzzles := Year(1996)
+	if len(puzzles) != 2 || puzzles[0].Day != 1 || puzzles[1].Day != 3 {
+		t.Errorf("Year(1996) = %v; want days 1 and 3", puzzles)
+	}
+
+	var years []int
+	for _, year := range Years() {
+		if year < 1997 {
+			years = append(years, year)
+		}
+	}
+	if len(years) != 2 || years[0] != 1995 || years[1] != 1996 {
+		t.Errorf("Years() = %v; want [1995 1996]", years)
+	}
+}
+
+type loggedSolver struct {
+	constSolver
+	logger *slog.Logger
+}
+
+func (s loggedSolver) WithLogger(l *slog.Logger) Solver {
+	s.logger = l
+	return s
+}
+
+func TestWithLogger(t *testing.T) {
+	want := slog.New(slog.DiscardHandler)
+	puzzle := Puzzle{Year: 1994, Day: 1, Solver: loggedSolver{constSolver: "a"}}.WithLogger(want)
+	if got := puzzle.Solver.(loggedSolver).logger; got != want {
+		t.Error("WithLogger() did not reach the solver")
+	}
+
+	// Solvers that do not log are left alone
+	plain := Puzzle{Year: 1994, Day: 2, Solver: constSolver("b")}
+	if got := plain.WithLogger(want).Solver; got != plain.Solver {
+		t.Errorf("WithLogger() changed a solver that does not log to %v", got)
+	}
+}
+
+func TestTracing(t *testing.T) {
+	var buf bytes.Buffer
+	tests := []struct {
+		name     string
+		logger   *slog.Logger
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"debug", slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})), false},
+		{"trace", slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: LevelTrace})), true},
+	}
+
+	for _, tt := range tests {
+		buf.Reset()
+		if got := Tracing(tt.logger); got != tt.expected {
+			t.Errorf("%s: Tracing() = %t; want %t", tt.name, got, tt.expected)
+		}
+		Trace(tt.logger, "step")
+		if logged := strings.Contains(buf.String(), "msg=step"); logged != tt.expected {
+			t.Errorf("%s: Trace() logged %t; want %t", tt.name, logged, tt.expected)
+		}
+	}
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	Register(1997, 1, constSolver("a"))
+	defer func() {
+		if recover() == nil {
+			t.Error("Register() twice did not panic")
+		}
+	}()
+	Register(1997, 1, constSolver("a"))
+}
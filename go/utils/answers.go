@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Answers is a local store of known-good answers keyed by year/day/part,
+// used to catch regressions after refactoring. It is kept outside the
+// repository so real answers are never committed.
+type Answers struct {
+	path string
+
+	mu      sync.Mutex
+	answers map[string]string
+}
+
+// DefaultAnswersPath returns the answers file location under the user config dir.
+func DefaultAnswersPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config dir: %w", err)
+	}
+	return filepath.Join(dir, "adventOfCode", "answers.json"), nil
+}
+
+// OpenAnswers loads the answers file at path. A missing file yields an empty
+// store that is created on the first Set.
+func OpenAnswers(path string) (*Answers, error) {
+	a := &Answers{path: path, answers: map[string]string{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return a, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read answers: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &a.answers); err != nil {
+		return nil, fmt.Errorf("failed to parse answers %s: %w", path, err)
+	}
+	return a, nil
+}
+
+// Get returns the expected answer for a part, if one is recorded.
+func (a *Answers) Get(year, day, part int) (string, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	answer, ok := a.answers[ledgerKey(year, day, part)]
+	return answer, ok
+}
+
+// Set records the expected answer for a part and saves the store.
+func (a *Answers) Set(year, day, part int, answer string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.answers[ledgerKey(year, day, part)] = answer
+	data, err := json.MarshalIndent(a.answers, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode answers: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(a.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create answers dir: %w", err)
+	}
+	return writeFileAtomic(a.path, data)
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestAnswers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	answers, err := OpenAnswers(path)
+	if err != nil {
+		t.Fatalf("OpenAnswers() unexpected error: %v", err)
+	}
+	if _, ok := answers.Get(2025, 7, 1); ok {
+		t.Error("Get() on empty store found an answer")
+	}
+
+	if err := answers.Set(2025, 7, 1, "21"); err != nil {
+		t.Fatalf("Set() unexpected error: %v", err)
+	}
+	answers.Set(2025, 7, 2, "40")
+
+	reopened, err := OpenAnswers(path)
+	if err != nil {
+		t.Fatalf("OpenAnswers() reopen unexpected error: %v", err)
+	}
+	for part, expected := range map[int]string{1: "21", 2: "40"} {
+		if got, ok := reopened.Get(2025, 7, part); !ok || got != expected {
+			t.Errorf("Get(2025, 7, %d) = %q, %v; want %q, true", part, got, ok, expected)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrCorrupt is returned for cache files that fail their checksum.
+var ErrCorrupt = errors.New("cache file corrupt")
+
+const checksumSuffix = ".sha256"
+
+// Cache stores fetched inputs under a directory namespaced by session, so
+// different users and sessions never share files. Every file is written
+// atomically alongside a checksum that is checked on each read.
+type Cache struct {
+	// Dir is the namespaced directory holding one sub-directory per year.
+	Dir string
+}
+
+// CacheEntry describes one cached file.
+type CacheEntry struct {
+	Year, Day int
+	Name      string
+	Path      string
+	Size      int64
+	ModTime   time.Time
+}
+
+// CacheProblem is a cached file that failed verification.
+type CacheProblem struct {
+	CacheEntry
+	Err error
+}
+
+// DefaultCacheRoot returns the cache root under the user cache dir, which
+// honours XDG_CACHE_HOME.
+func DefaultCacheRoot() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate cache dir: %w", err)
+	}
+	return filepath.Join(dir, "adventOfCode"), nil
+}
+
+// NewCache returns the cache under root for the given session token.
+func NewCache(root, session string) *Cache {
+	sum := sha256.Sum256([]byte(session))
+	return &Cache{Dir: filepath.Join(root, hex.EncodeToString(sum[:])[:12])}
+}
+
+// DefaultCache returns the cache for AOC_SESSION under DefaultCacheRoot.
+func DefaultCache() (*Cache, error) {
+	root, err := DefaultCacheRoot()
+	if err != nil {
+		return nil, err
+	}
+	return NewCache(root, os.Getenv("AOC_SESSION")), nil
+}
+
+// Path returns the location of a cached file for a day and year.
+func (c *Cache) Path(day, year int, name string) string {
+	return filepath.Join(c.Dir, strconv.Itoa(year), fmt.Sprintf("day%02d%s", day, name))
+}
+
+// Input implements InputSource, serving only inputs that pass verification.
+func (c *Cache) Input(_ context.Context, day, year int) ([]string, error) {
+	data, err := c.Load(day, year, ".txt")
+	if err != nil {
+		return nil, err
+	}
+	return readLines(bytes.NewReader(data))
+}
+
+// Load reads a cached file, failing with ErrCorrupt if it does not match
+// its checksum.
+func (c *Cache) Load(day, year int, name string) ([]byte, error) {
+	path := c.Path(day, year, name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache file: %w", err)
+	}
+	if err := checkSum(path, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Store atomically writes a cached file and its checksum.
+func (c *Cache) Store(day, year int, name string, data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("refusing to cache empty day %d %s", day, name)
+	}
+
+	path := c.Path(day, year, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create cache dir: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	if err := writeFileAtomic(path, data); err != nil {
+		return err
+	}
+	return writeFileAtomic(path+checksumSuffix, []byte(hex.EncodeToString(sum[:])+"\n"))
+}
+
+// List returns every cached file, ordered by year, day and name.
+func (c *Cache) List() ([]CacheEntry, error) {
+	var entries []CacheEntry
+	err := filepath.WalkDir(c.Dir, func(path string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) && path == c.Dir {
+			return fs.SkipAll
+		}
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(path, checksumSuffix) || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+
+		year, errYear := strconv.Atoi(filepath.Base(filepath.Dir(path)))
+		var day int
+		_, errDay := fmt.Sscanf(d.Name(), "day%02d", &day)
+		if errYear != nil || errDay != nil {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, CacheEntry{
+			Year:    year,
+			Day:     day,
+			Name:    d.Name(),
+			Path:    path,
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cache: %w", err)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Year != entries[j].Year {
+			return entries[i].Year < entries[j].Year
+		}
+		if entries[i].Day != entries[j].Day {
+			return entries[i].Day < entries[j].Day
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
+}
+
+// Verify checks every cached file against its checksum and returns those
+// that fail.
+func (c *Cache) Verify() ([]CacheProblem, error) {
+	entries, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []CacheProblem
+	for _, entry := range entries {
+		data, err := os.ReadFile(entry.Path)
+		if err == nil {
+			err = checkSum(entry.Path, data)
+		}
+		if err != nil {
+			problems = append(problems, CacheProblem{CacheEntry: entry, Err: err})
+		}
+	}
+	return problems, nil
+}
+
+// Purge removes cached files for a year, or the whole namespace if year is 0.
+func (c *Cache) Purge(year int) error {
+	dir := c.Dir
+	if year != 0 {
+		dir = filepath.Join(c.Dir, strconv.Itoa(year))
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to purge cache: %w", err)
+	}
+	return nil
+}
+
+// checkSum compares data with the checksum stored next to path.
+func checkSum(path string, data []byte) error {
+	want, err := os.ReadFile(path + checksumSuffix)
+	if err != nil {
+		return fmt.Errorf("%w: %s has no checksum", ErrCorrupt, path)
+	}
+	sum := sha256.Sum256(data)
+	if strings.TrimSpace(string(want)) != hex.EncodeToString(sum[:]) {
+		return fmt.Errorf("%w: %s does not match its checksum", ErrCorrupt, path)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temp file in the target directory and
+// renames it into place, so readers never see a partial file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestCacheNamespacedBySession(t *testing.T) {
+	root := t.TempDir()
+	alice := NewCache(root, "alice")
+	bob := NewCache(root, "bob")
+
+	if alice.Dir == bob.Dir {
+		t.Fatalf("sessions share cache dir %s", alice.Dir)
+	}
+	if err := alice.Store(1, 2025, ".txt", []byte("R1\n")); err != nil {
+		t.Fatalf("Store() unexpected error: %v", err)
+	}
+	if _, err := bob.Input(context.Background(), 1, 2025); err == nil {
+		t.Error("Input() from other session expected error but got nil")
+	}
+}
+
+func TestCacheRejectsCorruptFiles(t *testing.T) {
+	cache := NewCache(t.TempDir(), "secret")
+	if err := cache.Store(2, 2025, ".txt", []byte("11-22,95-115\n")); err != nil {
+		t.Fatalf("Store() unexpected error: %v", err)
+	}
+
+	lines, err := cache.Input(context.Background(), 2, 2025)
+	if err != nil {
+		t.Fatalf("Input() unexpected error: %v", err)
+	}
+	if len(lines) != 1 || lines[0] != "11-22,95-115" {
+		t.Errorf("Input() = %q; want [11-22,95-115]", lines)
+	}
+
+	// Simulate a half-written file left behind by an older version
+	if err := os.WriteFile(cache.Path(2, 2025, ".txt"), []byte("11-2"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.Input(context.Background(), 2, 2025); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("Input() error = %v; want ErrCorrupt", err)
+	}
+
+	problems, err := cache.Verify()
+	if err != nil {
+		t.Fatalf("Verify() unexpected error: %v", err)
+	}
+	if len(problems) != 1 || problems[0].Day != 2 {
+		t.Errorf("Verify() = %+v; want one problem for day 2", problems)
+	}
+}
+
+func TestCacheListAndPurge(t *testing.T) {
+	cache := NewCache(t.TempDir(), "secret")
+
+	entries, err := cache.List()
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("List() on empty cache = %v, %v; want no entries", entries, err)
+	}
+
+	cache.Store(8, 2025, ".txt", []byte("1,2,3\n"))
+	cache.Store(1, 2025, ".txt", []byte("R1\n"))
+	cache.Store(1, 2024, ".txt", []byte("3   4\n"))
+
+	entries, err = cache.List()
+	if err != nil {
+		t.Fatalf("List() unexpected error: %v", err)
+	}
+	expected := [][2]int{{2024, 1}, {2025, 1}, {2025, 8}}
+	if len(entries) != len(expected) {
+		t.Fatalf("List() returned %d entries; want %d", len(entries), len(expected))
+	}
+	for i, entry := range entries {
+		if entry.Year != expected[i][0] || entry.Day != expected[i][1] {
+			t.Errorf("List()[%d] = %d day %d; want %d day %d", i, entry.Year, entry.Day, expected[i][0], expected[i][1])
+		}
+	}
+
+	if err := cache.Purge(2025); err != nil {
+		t.Fatalf("Purge(2025) unexpected error: %v", err)
+	}
+	if entries, _ = cache.List(); len(entries) != 1 {
+		t.Errorf("List() after Purge(2025) returned %d entries; want 1", len(entries))
+	}
+
+	if err := cache.Purge(0); err != nil {
+		t.Fatalf("Purge(0) unexpected error: %v", err)
+	}
+	if entries, _ = cache.List(); len(entries) != 0 {
+		t.Errorf("List() after Purge(0) returned %d entries; want 0", len(entries))
+	}
+}
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// DefaultBaseURL is the Advent of Code endpoint used unless overridden by
+// WithBaseURL or the AOC_BASE_URL environment variable.
+const DefaultBaseURL = "https://adventofcode.com"
+
+const userAgent = "github.com/manning0218/adventOfCode by manning0218@gmail.com"
+
+// Client talks to adventofcode.com, or any server mimicking it.
+type Client struct {
+	BaseURL string
+	Session string
+	// HTTPClient sends requests; nil means http.DefaultClient.
+	HTTPClient *http.Client
+	// Ledger records submissions and vets answers before they are sent.
+	// A nil Ledger does neither.
+	Ledger *Ledger
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithBaseURL points the client at a different server, e.g. an httptest fake.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.BaseURL = baseURL
+	}
+}
+
+// WithSession sets the session cookie instead of reading AOC_SESSION.
+func WithSession(session string) Option {
+	return func(c *Client) {
+		c.Session = session
+	}
+}
+
+// WithHTTPClient sets the underlying HTTP client.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.HTTPClient = httpClient
+	}
+}
+
+// WithLedger records submissions in the given ledger instead of an
+// in-memory one. A nil ledger turns recording off.
+func WithLedger(ledger *Ledger) Option {
+	return func(c *Client) {
+		c.Ledger = ledger
+	}
+}
+
+// NewClient creates a client configured from AOC_BASE_URL and AOC_SESSION,
+// then applies the given options.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		BaseURL:    DefaultBaseURL,
+		Session:    os.Getenv("AOC_SESSION"),
+		HTTPClient: http.DefaultClient,
+		Ledger:     &Ledger{},
+	}
+	if baseURL := os.Getenv("AOC_BASE_URL"); baseURL != "" {
+		c.BaseURL = baseURL
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.BaseURL = strings.TrimRight(c.BaseURL, "/")
+	return c
+}
+
+// FetchInput downloads the raw puzzle input for a day and year.
+func (c *Client) FetchInput(ctx context.Context, day, year int) ([]byte, error) {
+	return c.get(ctx, fmt.Sprintf("/%d/day/%d/input", year, day))
+}
+
+// newRequest builds an authenticated request against the client's base URL.
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", userAgent)
+	return req, nil
+}
+
+// get performs an authenticated GET and returns the full response body.
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+// do sends a request and returns the full response body of a 200 response.
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	if err := checkStatus(resp.StatusCode); err != nil {
+		return nil, err
+	}
+
+	// Read the whole body so a failed transfer is reported, not returned
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", req.URL.Path, err)
+	}
+	if resp.ContentLength >= 0 && int64(len(body)) != resp.ContentLength {
+		return nil, fmt.Errorf("failed to read %s: got %d of %d bytes", req.URL.Path, len(body), resp.ContentLength)
+	}
+	return body, nil
+}
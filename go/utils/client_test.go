@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/aoctest"
+)
+
+func TestNewClientBaseURL(t *testing.T) {
+	t.Setenv("AOC_BASE_URL", "http://localhost:8080/")
+
+	if c := NewClient(); c.BaseURL != "http://localhost:8080" {
+		t.Errorf("NewClient().BaseURL = %q; want %q", c.BaseURL, "http://localhost:8080")
+	}
+	if c := NewClient(WithBaseURL("http://example.test")); c.BaseURL != "http://example.test" {
+		t.Errorf("NewClient(WithBaseURL).BaseURL = %q; want %q", c.BaseURL, "http://example.test")
+	}
+}
+
+func TestHTTPSourceCachesInput(t *testing.T) {
+	server := aoctest.NewServer(t, "secret")
+	server.SetInput(2025, 1, "L68\nL30\nR48\n")
+
+	cache := NewCache(t.TempDir(), "secret")
+	client := NewClient(WithBaseURL(server.URL), WithSession("secret"))
+	source := ChainSource{cache, HTTPSource{Client: client, Cache: cache}}
+
+	for i := 0; i < 2; i++ {
+		lines, err := source.Input(context.Background(), 1, 2025)
+		if err != nil {
+			t.Fatalf("Input() unexpected error: %v", err)
+		}
+		if len(lines) != 3 || lines[2] != "R48" {
+			t.Errorf("Input() = %q; want [L68 L30 R48]", lines)
+		}
+	}
+
+	if got := server.Requests("/2025/day/1/input"); got != 1 {
+		t.Errorf("server saw %d input requests; want 1", got)
+	}
+	if _, err := os.Stat(cache.Path(1, 2025, ".txt")); err != nil {
+		t.Errorf("cache file missing: %v", err)
+	}
+}
+
+func TestHTTPSourceErrors(t *testing.T) {
+	server := aoctest.NewServer(t, "secret")
+	server.SetInput(2025, 3, "12345\n")
+
+	tests := []struct {
+		name     string
+		session  string
+		day      int
+		status   int
+		expected error
+	}{
+		{"No session", "", 3, 0, ErrNoSession},
+		{"Bad session", "wrong", 3, 0, ErrUnauthorized},
+		{"Not unlocked", "secret", 25, 0, ErrNotUnlocked},
+		{"Rate limited", "secret", 3, http.StatusTooManyRequests, ErrRateLimited},
+		{"Server error", "secret", 3, http.StatusInternalServerError, nil},
+		{"Server unavailable", "secret", 3, http.StatusServiceUnavailable, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.SetStatus(2025, tt.day, tt.status)
+			defer server.SetStatus(2025, tt.day, 0)
+
+			cache := NewCache(t.TempDir(), tt.session)
+			client := NewClient(WithBaseURL(server.URL), WithSession(tt.session))
+			source := HTTPSource{Client: client, Cache: cache}
+
+			_, err := source.Input(context.Background(), tt.day, 2025)
+			if err == nil {
+				t.Fatal("Input() expected error but got nil")
+			}
+			if tt.expected != nil && !errors.Is(err, tt.expected) {
+				t.Errorf("Input() error = %v; want %v", err, tt.expected)
+			}
+
+			entries, _ := cache.List()
+			if len(entries) != 0 {
+				t.Errorf("failed fetch left %d cache files", len(entries))
+			}
+		})
+	}
+}
+
+func TestHTTPSourceTruncated(t *testing.T) {
+	server := aoctest.NewServer(t, "secret")
+	server.SetInput(2025, 5, "3-5\n10-14\n\n1\n5\n")
+	server.SetTruncated(2025, 5, true)
+
+	cache := NewCache(t.TempDir(), "secret")
+	client := NewClient(WithBaseURL(server.URL), WithSession("secret"))
+	source := ChainSource{cache, HTTPSource{Client: client, Cache: cache}}
+
+	if _, err := source.Input(context.Background(), 5, 2025); err == nil {
+		t.Fatal("Input() expected error for truncated response but got nil")
+	}
+
+	// The failed transfer must not be served on the next attempt
+	server.SetTruncated(2025, 5, false)
+	lines, err := source.Input(context.Background(), 5, 2025)
+	if err != nil {
+		t.Fatalf("Input() unexpected error: %v", err)
+	}
+	if len(lines) != 5 {
+		t.Errorf("Input() returned %d lines; want 5", len(lines))
+	}
+}
+
+func TestHTTPSourceCancelled(t *testing.T) {
+	server := aoctest.NewServer(t, "secret")
+	server.SetInput(2025, 1, "R1\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	source := HTTPSource{Client: NewClient(WithBaseURL(server.URL), WithSession("secret"))}
+	if _, err := source.Input(ctx, 1, 2025); !errors.Is(err, context.Canceled) {
+		t.Errorf("Input() error = %v; want context.Canceled", err)
+	}
+}
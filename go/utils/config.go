@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Config holds settings read from the user's config file.
+type Config struct {
+	// Year is the event year commands use when none is given.
+	Year int `json:"year,omitempty"`
+}
+
+// DefaultConfigPath returns the config file location under the user config dir.
+func DefaultConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config dir: %w", err)
+	}
+	return filepath.Join(dir, "adventOfCode", "config.json"), nil
+}
+
+// LoadConfig reads the config file at path. A missing file yields the zero
+// Config.
+func LoadConfig(path string) (Config, error) {
+	var config Config
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return config, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// DefaultYear returns the event year to use when none is given: AOC_YEAR if
+// set, else the year in the config file, else the latest event to have
+// started by now.
+func DefaultYear(now time.Time) (int, error) {
+	if env := os.Getenv("AOC_YEAR"); env != "" {
+		year, err := strconv.Atoi(env)
+		if err != nil {
+			return 0, fmt.Errorf("invalid AOC_YEAR %q", env)
+		}
+		return year, nil
+	}
+
+	path, err := DefaultConfigPath()
+	if err != nil {
+		return 0, err
+	}
+	config, err := LoadConfig(path)
+	if err != nil {
+		return 0, err
+	}
+	if config.Year != 0 {
+		return config.Year, nil
+	}
+	return LatestEvent(now), nil
+}
+
+// LatestEvent returns the year of the most recent event to have started,
+// which is the current year from December onwards.
+func LatestEvent(now time.Time) int {
+	if now.Month() == time.December {
+		return now.Year()
+	}
+	return now.Year() - 1
+}
+
+var yearDirPattern = regexp.MustCompile(`^\d{4}$`)
+
+// yearFromDir returns the year of the innermost path element that is a
+// year, as in ".../2025/go/day07".
+func yearFromDir(dir string) (int, bool) {
+	for ; ; dir = filepath.Dir(dir) {
+		if base := filepath.Base(dir); yearDirPattern.MatchString(base) {
+			year, _ := strconv.Atoi(base)
+			return year, true
+		}
+		if parent := filepath.Dir(dir); parent == dir {
+			return 0, false
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLatestEvent(t *testing.T) {
+	tests := []struct {
+		now      time.Time
+		expected int
+	}{
+		{time.Date(2025, time.November, 30, 23, 0, 0, 0, time.UTC), 2024},
+		{time.Date(2025, time.December, 1, 5, 0, 0, 0, time.UTC), 2025},
+		{time.Date(2026, time.January, 10, 0, 0, 0, 0, time.UTC), 2025},
+	}
+
+	for _, tt := range tests {
+		if got := LatestEvent(tt.now); got != tt.expected {
+			t.Errorf("LatestEvent(%s) = %d; want %d", tt.now.Format(time.DateOnly), got, tt.expected)
+		}
+	}
+}
+
+func TestDefaultYear(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	t.Setenv("AOC_YEAR", "")
+
+	if got, err := DefaultYear(now); err != nil || got != 2025 {
+		t.Errorf("DefaultYear() without config = %d, %v; want 2025", got, err)
+	}
+
+	path := filepath.Join(configDir, "adventOfCode", "config.json")
+	os.MkdirAll(filepath.Dir(path), 0o755)
+	os.WriteFile(path, []byte(`{"year": 2023}`), 0o644)
+	if got, err := DefaultYear(now); err != nil || got != 2023 {
+		t.Errorf("DefaultYear() with config = %d, %v; want 2023", got, err)
+	}
+
+	t.Setenv("AOC_YEAR", "2019")
+	if got, err := DefaultYear(now); err != nil || got != 2019 {
+		t.Errorf("DefaultYear() with AOC_YEAR = %d, %v; want 2019", got, err)
+	}
+
+	t.Setenv("AOC_YEAR", "last")
+	if _, err := DefaultYear(now); err == nil {
+		t.Error("DefaultYear() expected error for invalid AOC_YEAR")
+	}
+}
+
+func TestYearFromDir(t *testing.T) {
+	tests := []struct {
+		dir      string
+		expected int
+		ok       bool
+	}{
+		{"/src/adventOfCode/2025/go/day07", 2025, true},
+		{"/src/adventOfCode/2019/go/day01/testdata", 2019, true},
+		{"/src/adventOfCode/go/utils", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := yearFromDir(tt.dir)
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("yearFromDir(%q) = %d, %t; want %d, %t", tt.dir, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/manning0218/adventOfCode/go/layout"
+)
+
+// ErrNoExample is returned when a puzzle page has fewer examples than asked for.
+var ErrNoExample = errors.New("example not found")
+
+var codeBlockPattern = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+
+// ReadExample returns the nth (1-based) example input for a puzzle. The year
+// is taken from the working directory, which go test sets to the package
+// directory such as 2025/go/day07, falling back to DefaultYear. It panics on
+// any failure; use ReadExampleContext to handle errors.
+func ReadExample(day, n int) []string {
+	year, err := exampleYear()
+	if err != nil {
+		panic(err.Error())
+	}
+	lines, err := ReadExampleContext(context.Background(), day, year, n)
+	if err != nil {
+		panic(err.Error())
+	}
+	return lines
+}
+
+// exampleYear returns the year of the package being tested.
+func exampleYear() (int, error) {
+	if dir, err := os.Getwd(); err == nil {
+		if year, ok := yearFromDir(dir); ok {
+			return year, nil
+		}
+	}
+	return DefaultYear(time.Now())
+}
+
+// ReadExampleContext returns the nth (1-based) example input for a puzzle.
+// It looks for testdata/exampleN.txt in the day's package directory first,
+// <year>/go/dayNN under the module holding the working directory, then the
+// default cache, and finally downloads the puzzle page and caches all of
+// its examples.
+func ReadExampleContext(ctx context.Context, day, year, n int) ([]string, error) {
+	if local, ok := localExample(day, year, n); ok {
+		return readLinesFromFile(local)
+	}
+
+	cache, err := DefaultCache()
+	if err != nil {
+		return nil, err
+	}
+	if data, err := cache.Load(day, year, exampleName(n)); err == nil {
+		return readLines(bytes.NewReader(data))
+	}
+
+	examples, err := FetchExamples(ctx, NewClient(), cache, day, year)
+	if err != nil {
+		return nil, err
+	}
+	if n < 1 || n > len(examples) {
+		return nil, fmt.Errorf("%w: day %d has %d examples, asked for %d", ErrNoExample, day, len(examples), n)
+	}
+	return readLines(strings.NewReader(examples[n-1]))
+}
+
+// localExample returns the path of the nth example in the day's testdata
+// directory, if the working directory is inside the module and it exists.
+func localExample(day, year, n int) (string, bool) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", false
+	}
+	l, err := layout.Find(dir)
+	if err != nil {
+		return "", false
+	}
+	local := filepath.Join(l.DayDir(year, day), "testdata", fmt.Sprintf("example%d.txt", n))
+	if _, err := os.Stat(local); err != nil {
+		return "", false
+	}
+	return local, true
+}
+
+// FetchExamples downloads a puzzle page, extracts its examples and stores
+// them in cache as numbered example files when cache is not nil.
+func FetchExamples(ctx context.Context, client *Client, cache *Cache, day, year int) ([]string, error) {
+	page, err := client.FetchPuzzle(ctx, day, year)
+	if err != nil {
+		return nil, err
+	}
+
+	examples := ExtractExamples(string(page))
+	if cache != nil {
+		for i, example := range examples {
+			if err := cache.Store(day, year, exampleName(i+1), []byte(example)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return examples, nil
+}
+
+// FetchPuzzle downloads the HTML puzzle page for a day and year.
+func (c *Client) FetchPuzzle(ctx context.Context, day, year int) ([]byte, error) {
+	return c.get(ctx, fmt.Sprintf("/%d/day/%d", year, day))
+}
+
+// ExtractExamples returns the text of every <pre><code> block inside the
+// page's articles, in order, with markup removed and entities decoded.
+func ExtractExamples(page string) []string {
+	var examples []string
+	for _, article := range articlePattern.FindAllStringSubmatch(page, -1) {
+		for _, block := range codeBlockPattern.FindAllStringSubmatch(article[1], -1) {
+			text := html.UnescapeString(tagPattern.ReplaceAllString(block[1], ""))
+			if !strings.HasSuffix(text, "\n") {
+				text += "\n"
+			}
+			examples = append(examples, text)
+		}
+	}
+	return examples
+}
+
+// exampleName is the cache file suffix for the nth example of a day.
+func exampleName(n int) string {
+	return fmt.Sprintf(".example%d.txt", n)
+}
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/manning0218/adventOfCode/go/aoctest"
+)
+
+const puzzlePage = `<html><body><main>
+<article class="day-desc"><h2>--- Day 7: Laboratories ---</h2>
+<p>For example:</p>
+<pre><code>..S..
+..^..
+</code></pre>
+<p>Only <code>inline</code> code is not an example.</p>
+</article>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2>
+<pre><code>1 &lt; 2 &amp;&amp; <em>3</em>
+</code></pre>
+</article>
+</main></body></html>`
+
+func TestExtractExamples(t *testing.T) {
+	examples := ExtractExamples(puzzlePage)
+	expected := []string{"..S..\n..^..\n", "1 < 2 && 3\n"}
+
+	if len(examples) != len(expected) {
+		t.Fatalf("ExtractExamples() returned %d examples; want %d", len(examples), len(expected))
+	}
+	for i := range examples {
+		if examples[i] != expected[i] {
+			t.Errorf("ExtractExamples()[%d] = %q; want %q", i, examples[i], expected[i])
+		}
+	}
+}
+
+func TestFetchExamples(t *testing.T) {
+	server := aoctest.NewServer(t, "secret")
+	server.SetPuzzle(2025, 7, puzzlePage)
+
+	cache := NewCache(t.TempDir(), "secret")
+	client := NewClient(WithBaseURL(server.URL), WithSession("secret"))
+
+	examples, err := FetchExamples(context.Background(), client, cache, 7, 2025)
+	if err != nil {
+		t.Fatalf("FetchExamples() unexpected error: %v", err)
+	}
+	if len(examples) != 2 {
+		t.Fatalf("FetchExamples() returned %d examples; want 2", len(examples))
+	}
+
+	data, err := cache.Load(7, 2025, exampleName(2))
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if string(data) != "1 < 2 && 3\n" {
+		t.Errorf("cached example 2 = %q; want %q", data, "1 < 2 && 3\n")
+	}
+}
+
+func TestReadExampleContextLocal(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":                              "module example.com/aoc\n",
+		"2024/go/day03/testdata/example1.txt": "2024 day 3\n",
+		"2025/go/day07/testdata/example1.txt": "2025 day 7\n",
+		"2025/go/day07/testdata/example2.txt": "2025 day 7 part 2\n",
+	}
+	for name, data := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Work from day 7's package; other days must still resolve to their own.
+	t.Chdir(filepath.Join(root, "2025", "go", "day07"))
+
+	tests := []struct {
+		day, year, n int
+		expected     []string
+	}{
+		{7, 2025, 1, []string{"2025 day 7"}},
+		{7, 2025, 2, []string{"2025 day 7 part 2"}},
+		{3, 2024, 1, []string{"2024 day 3"}},
+	}
+	for _, tt := range tests {
+		got, err := ReadExampleContext(context.Background(), tt.day, tt.year, tt.n)
+		if err != nil {
+			t.Errorf("ReadExampleContext(%d, %d, %d) unexpected error: %v", tt.day, tt.year, tt.n, err)
+			continue
+		}
+		if !slices.Equal(got, tt.expected) {
+			t.Errorf("ReadExampleContext(%d, %d, %d) = %q; want %q", tt.day, tt.year, tt.n, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+var (
+	// ErrNoSession is returned when AOC_SESSION is not set.
+	ErrNoSession = errors.New("AOC_SESSION environment variable not set")
+	// ErrNotUnlocked is returned when the puzzle input is not available yet.
+	ErrNotUnlocked = errors.New("puzzle input not unlocked yet")
+	// ErrUnauthorized is returned when the session cookie is rejected.
+	ErrUnauthorized = errors.New("session rejected by adventofcode.com")
+	// ErrRateLimited is returned when adventofcode.com throttles requests.
+	ErrRateLimited = errors.New("rate limited by adventofcode.com")
+)
+
+// ReadInput fetches the Advent of Code input for the given day of the
+// DefaultYear. It caches the input under the user cache dir to avoid repeated
+// requests. Requires AOC_SESSION environment variable to be set with your
+// session cookie, unless AOC_INPUT points at a local file, a directory or "-"
+// for stdin.
+func ReadInput(day int) []string {
+	year, err := DefaultYear(time.Now())
+	if err != nil {
+		panic(err.Error())
+	}
+	return ReadInputForYear(day, year)
+}
+
+// ReadInputForYear fetches input for a specific day and year.
+// It panics on any failure; use ReadInputContext to handle errors.
+func ReadInputForYear(day, year int) []string {
+	lines, err := ReadInputContext(context.Background(), day, year)
+	if err != nil {
+		panic(err.Error())
+	}
+	return lines
+}
+
+// ReadInputContext fetches input for a specific day and year from
+// DefaultSource, returning an error instead of panicking. The context bounds
+// any HTTP request.
+func ReadInputContext(ctx context.Context, day, year int) ([]string, error) {
+	return DefaultSource.Input(ctx, day, year)
+}
+
+// checkStatus maps an adventofcode.com response status to a sentinel error.
+func checkStatus(status int) error {
+	switch status {
+	case http.StatusOK:
+		return nil
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: status %d", ErrUnauthorized, status)
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: status %d", ErrNotUnlocked, status)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: status %d", ErrRateLimited, status)
+	}
+	return fmt.Errorf("failed to fetch input: status %d", status)
+}
+
+// readLinesFromFile reads all lines from a file and returns them as a slice.
+func readLinesFromFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	return readLines(file)
+}
+
+// readLines reads all lines from r and returns them as a slice.
+func readLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return lines, nil
+}
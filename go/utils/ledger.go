@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrRuledOut is returned when earlier too high/too low verdicts already
+	// rule an answer out.
+	ErrRuledOut = errors.New("answer ruled out by earlier verdicts")
+	// ErrAlreadySolved is returned when the ledger already holds the correct
+	// answer for a part.
+	ErrAlreadySolved = errors.New("part already solved")
+)
+
+// LedgerEntry records one submitted answer and its verdict.
+type LedgerEntry struct {
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// Ledger remembers every submitted answer, keyed by year/day/part, and
+// persists them as JSON. A ledger with an empty path lives in memory only,
+// and a nil *Ledger remembers nothing.
+type Ledger struct {
+	path string
+
+	mu      sync.Mutex
+	entries map[string][]LedgerEntry
+}
+
+// DefaultLedgerPath returns the ledger location under the user config dir.
+func DefaultLedgerPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config dir: %w", err)
+	}
+	return filepath.Join(dir, "adventOfCode", "ledger.json"), nil
+}
+
+// OpenLedger loads the ledger at path. A missing file yields an empty ledger
+// that is created on the first Record.
+func OpenLedger(path string) (*Ledger, error) {
+	l := &Ledger{path: path, entries: map[string][]LedgerEntry{}}
+	if path == "" {
+		return l, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ledger: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &l.entries); err != nil {
+		return nil, fmt.Errorf("failed to parse ledger %s: %w", path, err)
+	}
+	return l, nil
+}
+
+// ledgerKey formats the year/day/part key used in the JSON file.
+func ledgerKey(year, day, part int) string {
+	return fmt.Sprintf("%d/%02d/%d", year, day, part)
+}
+
+// Entries returns the answers recorded for a part, oldest first.
+func (l *Ledger) Entries(year, day, part int) []LedgerEntry {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]LedgerEntry(nil), l.entries[ledgerKey(year, day, part)]...)
+}
+
+// Correct returns the answer recorded as correct for a part, if any.
+func (l *Ledger) Correct(year, day, part int) (string, bool) {
+	for _, entry := range l.Entries(year, day, part) {
+		if entry.Verdict == Correct {
+			return entry.Answer, true
+		}
+	}
+	return "", false
+}
+
+// Check reports whether an answer is worth submitting. It returns
+// ErrKnownWrong for a previously rejected answer, ErrRuledOut when earlier
+// too high/too low verdicts exclude it and ErrAlreadySolved once the part has
+// a correct answer.
+func (l *Ledger) Check(year, day, part int, answer string) error {
+	if correct, ok := l.Correct(year, day, part); ok {
+		return fmt.Errorf("%w with %s", ErrAlreadySolved, correct)
+	}
+
+	value, numeric := parseAnswer(answer)
+	for _, entry := range l.Entries(year, day, part) {
+		if entry.Answer == answer && entry.Verdict.IsRejection() {
+			return fmt.Errorf("%w: %s was %s", ErrKnownWrong, answer, entry.Verdict)
+		}
+
+		bound, ok := parseAnswer(entry.Answer)
+		if !numeric || !ok {
+			continue
+		}
+		if entry.Verdict == TooHigh && value >= bound {
+			return fmt.Errorf("%w: %s >= %s which was too high", ErrRuledOut, answer, entry.Answer)
+		}
+		if entry.Verdict == TooLow && value <= bound {
+			return fmt.Errorf("%w: %s <= %s which was too low", ErrRuledOut, answer, entry.Answer)
+		}
+	}
+	return nil
+}
+
+// Record appends a verdict for an answer and saves the ledger.
+func (l *Ledger) Record(year, day, part int, answer string, verdict Verdict, at time.Time) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.entries == nil {
+		l.entries = map[string][]LedgerEntry{}
+	}
+	key := ledgerKey(year, day, part)
+	l.entries[key] = append(l.entries[key], LedgerEntry{Answer: answer, Verdict: verdict, Time: at})
+	return l.save()
+}
+
+// save writes the ledger atomically.
+// The caller must hold l.mu.
+func (l *Ledger) save() error {
+	if l.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(l.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode ledger: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create ledger dir: %w", err)
+	}
+	return writeFileAtomic(l.path, data)
+}
+
+// parseAnswer returns the numeric value of an answer, if it has one.
+func parseAnswer(answer string) (int64, bool) {
+	value, err := strconv.ParseInt(answer, 10, 64)
+	return value, err == nil
+}
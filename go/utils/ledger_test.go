@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLedgerCheck(t *testing.T) {
+	ledger, err := OpenLedger("")
+	if err != nil {
+		t.Fatalf("OpenLedger() unexpected error: %v", err)
+	}
+	now := time.Now()
+	ledger.Record(2025, 1, 1, "500", TooHigh, now)
+	ledger.Record(2025, 1, 1, "100", TooLow, now)
+	ledger.Record(2025, 1, 1, "250", Wrong, now)
+
+	tests := []struct {
+		answer   string
+		expected error
+	}{
+		{"250", ErrKnownWrong},
+		{"500", ErrKnownWrong},
+		{"600", ErrRuledOut},
+		{"100", ErrKnownWrong},
+		{"42", ErrRuledOut},
+		{"101", nil},
+		{"499", nil},
+		{"abc", nil},
+	}
+
+	for _, tt := range tests {
+		err := ledger.Check(2025, 1, 1, tt.answer)
+		if !errors.Is(err, tt.expected) {
+			t.Errorf("Check(%s) = %v; want %v", tt.answer, err, tt.expected)
+		}
+	}
+
+	// Other parts are unaffected
+	if err := ledger.Check(2025, 1, 2, "600"); err != nil {
+		t.Errorf("Check() for part 2 = %v; want nil", err)
+	}
+
+	ledger.Record(2025, 1, 1, "300", Correct, now)
+	if err := ledger.Check(2025, 1, 1, "301"); !errors.Is(err, ErrAlreadySolved) {
+		t.Errorf("Check() after correct = %v; want ErrAlreadySolved", err)
+	}
+}
+
+func TestLedgerPersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "ledger.json")
+	ledger, err := OpenLedger(path)
+	if err != nil {
+		t.Fatalf("OpenLedger() unexpected error: %v", err)
+	}
+
+	at := time.Date(2025, 12, 1, 5, 0, 0, 0, time.UTC)
+	if err := ledger.Record(2025, 7, 2, "40", TooLow, at); err != nil {
+		t.Fatalf("Record() unexpected error: %v", err)
+	}
+	if err := ledger.Record(2025, 7, 2, "41", Correct, at); err != nil {
+		t.Fatalf("Record() unexpected error: %v", err)
+	}
+
+	reopened, err := OpenLedger(path)
+	if err != nil {
+		t.Fatalf("OpenLedger() reopen unexpected error: %v", err)
+	}
+
+	entries := reopened.Entries(2025, 7, 2)
+	if len(entries) != 2 {
+		t.Fatalf("Entries() returned %d entries; want 2", len(entries))
+	}
+	if entries[0] != (LedgerEntry{Answer: "40", Verdict: TooLow, Time: at}) {
+		t.Errorf("Entries()[0] = %+v; want 40 too low at %v", entries[0], at)
+	}
+	if answer, ok := reopened.Correct(2025, 7, 2); !ok || answer != "41" {
+		t.Errorf("Correct() = %q, %v; want 41, true", answer, ok)
+	}
+}
@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"slices"
+	"sync"
+)
+
+// InputSource provides the puzzle input for a given day and year.
+type InputSource interface {
+	Input(ctx context.Context, day, year int) ([]string, error)
+}
+
+// DefaultSource is the source used by ReadInput and ReadInputContext.
+// It honours AOC_INPUT and otherwise reads the persistent cache before
+// fetching from adventofcode.com.
+var DefaultSource InputSource = sourceFromEnv()
+
+// sourceFromEnv builds the default source. AOC_INPUT may be "-" for stdin,
+// a directory of dayNN.txt files or a single input file.
+func sourceFromEnv() InputSource {
+	switch path := os.Getenv("AOC_INPUT"); {
+	case path == "-":
+		return StdinSource()
+	case path != "":
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			return FSSource{FS: os.DirFS(path)}
+		}
+		return FileSource(path)
+	}
+
+	cache, err := DefaultCache()
+	if err != nil {
+		return HTTPSource{}
+	}
+	return ChainSource{cache, HTTPSource{Cache: cache}}
+}
+
+// FileSource reads the input from a single local file, whatever the day.
+type FileSource string
+
+// Input implements InputSource.
+func (f FileSource) Input(_ context.Context, _, _ int) ([]string, error) {
+	return readLinesFromFile(string(f))
+}
+
+// ReaderSource reads the input from an io.Reader. The reader is consumed on
+// the first call; later calls, concurrent ones included, return the same
+// lines.
+type ReaderSource struct {
+	R     io.Reader
+	mu    sync.Mutex
+	lines []string
+	read  bool
+}
+
+// StdinSource returns a source reading the input from standard input.
+func StdinSource() *ReaderSource {
+	return &ReaderSource{R: os.Stdin}
+}
+
+// Input implements InputSource.
+func (r *ReaderSource) Input(_ context.Context, _, _ int) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.read {
+		return r.lines, nil
+	}
+	lines, err := readLines(r.R)
+	if err != nil {
+		return nil, err
+	}
+	r.lines, r.read = lines, true
+	return lines, nil
+}
+
+// IgnoresDay reports whether s returns the same input whatever the day, as
+// FileSource and ReaderSource do, or a chain of sources may.
+func IgnoresDay(s InputSource) bool {
+	switch s := s.(type) {
+	case FileSource, *ReaderSource:
+		return true
+	case ChainSource:
+		return slices.ContainsFunc(s, IgnoresDay)
+	}
+	return false
+}
+
+// FSSource reads inputs from a file system such as os.DirFS, embed.FS or
+// fstest.MapFS.
+type FSSource struct {
+	FS fs.FS
+	// Name maps a day and year to a file name within FS. Defaults to dayNN.txt.
+	Name func(day, year int) string
+}
+
+// Input implements InputSource.
+func (s FSSource) Input(_ context.Context, day, year int) ([]string, error) {
+	name := fmt.Sprintf("day%02d.txt", day)
+	if s.Name != nil {
+		name = s.Name(day, year)
+	}
+
+	file, err := s.FS.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	return readLines(file)
+}
+
+// HTTPSource fetches inputs from adventofcode.com using AOC_SESSION.
+type HTTPSource struct {
+	// Client performs the requests. Defaults to NewClient().
+	Client *Client
+	// Cache, when set, receives a copy of every fetched input.
+	Cache *Cache
+}
+
+// Input implements InputSource.
+func (s HTTPSource) Input(ctx context.Context, day, year int) ([]string, error) {
+	client := s.Client
+	if client == nil {
+		client = NewClient()
+	}
+
+	body, err := client.FetchInput(ctx, day, year)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cache != nil {
+		if err := s.Cache.Store(day, year, ".txt", body); err != nil {
+			return nil, err
+		}
+	}
+
+	return readLines(bytes.NewReader(body))
+}
+
+// ChainSource tries each source in order and returns the first input found.
+type ChainSource []InputSource
+
+// Input implements InputSource.
+func (c ChainSource) Input(ctx context.Context, day, year int) ([]string, error) {
+	var errs []error
+	for _, source := range c {
+		lines, err := source.Input(ctx, day, year)
+		if err == nil {
+			return lines, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return nil, errors.New("no input sources configured")
+	}
+	return nil, errors.Join(errs...)
+}
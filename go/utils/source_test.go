@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFSSource(t *testing.T) {
+	source := FSSource{FS: fstest.MapFS{
+		"day07.txt": {Data: []byte("..S..\n.....\n")},
+	}}
+
+	lines, err := source.Input(context.Background(), 7, 2025)
+	if err != nil {
+		t.Fatalf("Input() unexpected error: %v", err)
+	}
+	if len(lines) != 2 || lines[0] != "..S.." {
+		t.Errorf("Input() = %q; want [..S.. .....]", lines)
+	}
+
+	_, err = source.Input(context.Background(), 8, 2025)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Input() for missing day error = %v; want fs.ErrNotExist", err)
+	}
+}
+
+func TestReaderSource(t *testing.T) {
+	source := &ReaderSource{R: strings.NewReader("L68\nR48\n")}
+
+	for i := 0; i < 2; i++ {
+		lines, err := source.Input(context.Background(), 1, 2025)
+		if err != nil {
+			t.Fatalf("Input() unexpected error: %v", err)
+		}
+		if len(lines) != 2 || lines[1] != "R48" {
+			t.Errorf("Input() call %d = %q; want [L68 R48]", i, lines)
+		}
+	}
+}
+
+func TestReaderSourceConcurrent(t *testing.T) {
+	source := &ReaderSource{R: strings.NewReader("L68\nR48\n")}
+
+	var wg sync.WaitGroup
+	for day := 1; day <= 4; day++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lines, err := source.Input(context.Background(), day, 2025)
+			if err != nil || len(lines) != 2 {
+				t.Errorf("Input(%d) = %q, %v; want [L68 R48]", day, lines, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestIgnoresDay(t *testing.T) {
+	tests := []struct {
+		source   InputSource
+		expected bool
+	}{
+		{FileSource("input.txt"), true},
+		{&ReaderSource{R: strings.NewReader("")}, true},
+		{FSSource{FS: fstest.MapFS{}}, false},
+		{HTTPSource{}, false},
+		{ChainSource{FSSource{FS: fstest.MapFS{}}, HTTPSource{}}, false},
+		{ChainSource{FSSource{FS: fstest.MapFS{}}, FileSource("input.txt")}, true},
+	}
+
+	for _, tt := range tests {
+		if got := IgnoresDay(tt.source); got != tt.expected {
+			t.Errorf("IgnoresDay(%T) = %t; want %t", tt.source, got, tt.expected)
+		}
+	}
+}
+
+func TestChainSource(t *testing.T) {
+	chain := ChainSource{
+		FSSource{FS: fstest.MapFS{}},
+		FSSource{FS: fstest.MapFS{"day01.txt": {Data: []byte("R1\n")}}},
+	}
+
+	lines, err := chain.Input(context.Background(), 1, 2025)
+	if err != nil {
+		t.Fatalf("Input() unexpected error: %v", err)
+	}
+	if len(lines) != 1 || lines[0] != "R1" {
+		t.Errorf("Input() = %q; want [R1]", lines)
+	}
+
+	_, err = chain.Input(context.Background(), 2, 2025)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Input() for missing day error = %v; want fs.ErrNotExist", err)
+	}
+}
+
+func TestReadInputContextUsesDefaultSource(t *testing.T) {
+	saved := DefaultSource
+	defer func() { DefaultSource = saved }()
+	DefaultSource = FSSource{FS: fstest.MapFS{"day03.txt": {Data: []byte("987654321111111\n")}}}
+
+	lines, err := ReadInputContext(context.Background(), 3, 2025)
+	if err != nil {
+		t.Fatalf("ReadInputContext() unexpected error: %v", err)
+	}
+	if len(lines) != 1 || lines[0] != "987654321111111" {
+		t.Errorf("ReadInputContext() = %q; want [987654321111111]", lines)
+	}
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrKnownWrong is returned when an answer was already rejected and
+// submitting it again would only cost another timeout.
+var ErrKnownWrong = errors.New("answer already known to be wrong")
+
+// Verdict classifies the response to an answer submission.
+type Verdict int
+
+const (
+	Unknown Verdict = iota
+	Correct
+	TooHigh
+	TooLow
+	Wrong
+	AlreadySolved
+	RateLimited
+)
+
+var verdictNames = map[Verdict]string{
+	Unknown:       "unknown",
+	Correct:       "correct",
+	TooHigh:       "too high",
+	TooLow:        "too low",
+	Wrong:         "wrong",
+	AlreadySolved: "already solved",
+	RateLimited:   "rate limited",
+}
+
+func (v Verdict) String() string {
+	if name, ok := verdictNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("Verdict(%d)", int(v))
+}
+
+// MarshalText encodes the verdict by name for the ledger file.
+func (v Verdict) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText decodes a verdict name written by MarshalText.
+func (v *Verdict) UnmarshalText(text []byte) error {
+	for verdict, name := range verdictNames {
+		if name == string(text) {
+			*v = verdict
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown verdict %q", text)
+}
+
+// IsRejection reports whether the verdict rules the answer out for good.
+func (v Verdict) IsRejection() bool {
+	return v == TooHigh || v == TooLow || v == Wrong
+}
+
+// SubmitResult is the parsed outcome of an answer submission.
+type SubmitResult struct {
+	Verdict Verdict
+	// Wait is how long to wait before submitting again when rate limited.
+	Wait time.Duration
+	// Message is the plain text of the response article.
+	Message string
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+	waitPattern    = regexp.MustCompile(`(?:(\d+)m\s*)?(\d+)s left to wait`)
+)
+
+// Submit posts an answer for a part of a day's puzzle and classifies the
+// response. Answers the client's ledger already rules out are refused
+// without touching the network; every answer sent is recorded in it with
+// its verdict, rate limits included. Only rejections and Correct affect
+// later checks.
+func (c *Client) Submit(ctx context.Context, day, year, part int, answer string) (SubmitResult, error) {
+	answer = strings.TrimSpace(answer)
+	if err := c.Ledger.Check(year, day, part, answer); err != nil {
+		return SubmitResult{}, err
+	}
+
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	path := fmt.Sprintf("/%d/day/%d/answer", year, day)
+	req, err := c.newRequest(ctx, http.MethodPost, path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return SubmitResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := c.do(req)
+	if err != nil {
+		return SubmitResult{}, err
+	}
+
+	result := ParseSubmitResponse(string(body))
+	if err := c.Ledger.Record(year, day, part, answer, result.Verdict, time.Now()); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ParseSubmitResponse classifies the HTML returned by the answer endpoint.
+func ParseSubmitResponse(page string) SubmitResult {
+	text := page
+	if match := articlePattern.FindStringSubmatch(page); match != nil {
+		text = match[1]
+	}
+	text = html.UnescapeString(tagPattern.ReplaceAllString(text, ""))
+	text = strings.Join(strings.Fields(text), " ")
+
+	result := SubmitResult{Message: text}
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		result.Verdict = Correct
+	case strings.Contains(text, "your answer is too high"):
+		result.Verdict = TooHigh
+	case strings.Contains(text, "your answer is too low"):
+		result.Verdict = TooLow
+	case strings.Contains(text, "That's not the right answer"):
+		result.Verdict = Wrong
+	case strings.Contains(text, "You don't seem to be solving the right level"):
+		result.Verdict = AlreadySolved
+	case strings.Contains(text, "You gave an answer too recently"):
+		result.Verdict = RateLimited
+		if match := waitPattern.FindStringSubmatch(text); match != nil {
+			minutes, _ := strconv.Atoi(match[1])
+			seconds, _ := strconv.Atoi(match[2])
+			result.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+		}
+	}
+
+	return result
+}
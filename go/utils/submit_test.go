@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manning0218/adventOfCode/go/aoctest"
+)
+
+func TestParseSubmitResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     string
+		expected Verdict
+		wait     time.Duration
+	}{
+		{"Correct", aoctest.CorrectResponse, Correct, 0},
+		{"Too high", aoctest.TooHighResponse, TooHigh, 0},
+		{"Too low", aoctest.TooLowResponse, TooLow, 0},
+		{"Wrong", aoctest.WrongResponse, Wrong, 0},
+		{"Already solved", aoctest.AlreadySolvedResponse, AlreadySolved, 0},
+		{"Rate limited", aoctest.RateLimitedResponse, RateLimited, 34 * time.Second},
+		{"Rate limited minutes", "<article><p>You gave an answer too recently; You have 4m 2s left to wait.</p></article>", RateLimited, 4*time.Minute + 2*time.Second},
+		{"Unrecognised", "<html></html>", Unknown, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := "<main><article><p>" + tt.page + "</p></article></main>"
+			result := ParseSubmitResponse(page)
+			if result.Verdict != tt.expected {
+				t.Errorf("Verdict = %v; want %v", result.Verdict, tt.expected)
+			}
+			if result.Wait != tt.wait {
+				t.Errorf("Wait = %v; want %v", result.Wait, tt.wait)
+			}
+		})
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	server := aoctest.NewServer(t, "secret")
+	server.SetAnswer(2025, 1, 1, 3)
+	client := NewClient(WithBaseURL(server.URL), WithSession("secret"))
+	ctx := context.Background()
+
+	result, err := client.Submit(ctx, 1, 2025, 1, "7")
+	if err != nil {
+		t.Fatalf("Submit() unexpected error: %v", err)
+	}
+	if result.Verdict != TooHigh {
+		t.Errorf("Submit(7) verdict = %v; want %v", result.Verdict, TooHigh)
+	}
+
+	// A rejected answer is refused without another request
+	_, err = client.Submit(ctx, 1, 2025, 1, "7")
+	if !errors.Is(err, ErrKnownWrong) {
+		t.Errorf("Submit(7) again error = %v; want ErrKnownWrong", err)
+	}
+	if got := server.Requests("/2025/day/1/answer"); got != 1 {
+		t.Errorf("server saw %d submissions; want 1", got)
+	}
+
+	result, err = client.Submit(ctx, 1, 2025, 1, "3")
+	if err != nil {
+		t.Fatalf("Submit() unexpected error: %v", err)
+	}
+	if result.Verdict != Correct {
+		t.Errorf("Submit(3) verdict = %v; want %v", result.Verdict, Correct)
+	}
+
+	_, err = client.Submit(ctx, 1, 2025, 1, "3")
+	if !errors.Is(err, ErrAlreadySolved) {
+		t.Errorf("Submit(3) again error = %v; want ErrAlreadySolved", err)
+	}
+
+	// A client without the ledger history hears it from the server instead
+	fresh := NewClient(WithBaseURL(server.URL), WithSession("secret"))
+	result, _ = fresh.Submit(ctx, 1, 2025, 1, "3")
+	if result.Verdict != AlreadySolved {
+		t.Errorf("fresh Submit(3) verdict = %v; want %v", result.Verdict, AlreadySolved)
+	}
+
+	server.SetThrottled(true)
+	result, _ = client.Submit(ctx, 1, 2025, 2, "1")
+	if result.Verdict != RateLimited || result.Wait != 34*time.Second {
+		t.Errorf("throttled Submit() = %v after %v; want %v after 34s", result.Verdict, result.Wait, RateLimited)
+	}
+
+	// Every guess is recorded, but a rate limit does not rule the answer out
+	entries := client.Ledger.Entries(2025, 1, 2)
+	if len(entries) != 1 || entries[0].Verdict != RateLimited {
+		t.Errorf("Entries(part 2) = %+v; want one rate limited entry", entries)
+	}
+	if err := client.Ledger.Check(2025, 1, 2, "1"); err != nil {
+		t.Errorf("Check() after a rate limit error = %v; want nil", err)
+	}
+}
+
+func TestSubmitWithoutLedger(t *testing.T) {
+	server := aoctest.NewServer(t, "secret")
+	server.SetAnswer(2025, 1, 1, 3)
+	ctx := context.Background()
+
+	clients := map[string]*Client{
+		"literal":         {BaseURL: server.URL, Session: "secret"},
+		"WithLedger(nil)": NewClient(WithBaseURL(server.URL), WithSession("secret"), WithLedger(nil)),
+	}
+	for name, client := range clients {
+		for range 2 {
+			result, err := client.Submit(ctx, 1, 2025, 1, "7")
+			if err != nil {
+				t.Fatalf("%s: Submit() unexpected error: %v", name, err)
+			}
+			if result.Verdict != TooHigh {
+				t.Errorf("%s: Submit(7) verdict = %v; want %v", name, result.Verdict, TooHigh)
+			}
+		}
+	}
+	if got := server.Requests("/2025/day/1/answer"); got != 4 {
+		t.Errorf("server saw %d submissions; want 4 with no ledger to stop repeats", got)
+	}
+}
@@ -0,0 +1,106 @@
+// Package watch polls directories for file changes.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// fileState is what a Snapshot compares to spot a change.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// Snapshot records the state of every file found by Scan.
+type Snapshot map[string]fileState
+
+// Scan walks dirs recursively and records every file, skipping hidden files
+// and directories such as editor swap files.
+func Scan(dirs ...string) (Snapshot, error) {
+	snapshot := Snapshot{}
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if path != dir && strings.HasPrefix(d.Name(), ".") {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.IsDir() {
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			snapshot[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", dir, err)
+		}
+	}
+	return snapshot, nil
+}
+
+// Equal reports whether two snapshots hold the same files in the same state.
+func (s Snapshot) Equal(other Snapshot) bool {
+	return maps.Equal(s, other)
+}
+
+// Watcher polls a set of directories and calls back once changes settle.
+type Watcher struct {
+	Dirs []string
+	// Interval is how often the directories are scanned.
+	Interval time.Duration
+	// Debounce is how long the files must stay unchanged before a burst of
+	// changes triggers a single callback.
+	Debounce time.Duration
+}
+
+// Run calls onChange once straight away and then after every settled
+// change until ctx is done.
+func (w Watcher) Run(ctx context.Context, onChange func()) error {
+	last, err := Scan(w.Dirs...)
+	if err != nil {
+		return err
+	}
+	onChange()
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	var changedAt time.Time
+	pending := false
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			current, err := Scan(w.Dirs...)
+			if err != nil {
+				return err
+			}
+			if !current.Equal(last) {
+				last = current
+				changedAt = now
+				pending = true
+				continue
+			}
+			if pending && now.Sub(changedAt) >= w.Debounce {
+				pending = false
+				onChange()
+			}
+		}
+	}
+}
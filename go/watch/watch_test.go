@@ -0,0 +1,77 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScan(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "solver.go"), []byte("package day09\n"), 0o644)
+	os.Mkdir(filepath.Join(dir, "testdata"), 0o755)
+	os.WriteFile(filepath.Join(dir, "testdata", "example1.txt"), []byte("1\n"), 0o644)
+	os.WriteFile(filepath.Join(dir, ".solver.go.swp"), []byte("x"), 0o644)
+
+	before, err := Scan(dir)
+	if err != nil {
+		t.Fatalf("Scan() unexpected error: %v", err)
+	}
+	if len(before) != 2 {
+		t.Errorf("Scan() found %d files; want 2: %v", len(before), before)
+	}
+
+	os.WriteFile(filepath.Join(dir, "testdata", "example1.txt"), []byte("12\n"), 0o644)
+	after, err := Scan(dir)
+	if err != nil {
+		t.Fatalf("Scan() unexpected error: %v", err)
+	}
+	if before.Equal(after) {
+		t.Error("Equal() = true after a file changed")
+	}
+}
+
+func TestWatcherDebounces(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "solver.go")
+	os.WriteFile(path, []byte("package day09\n"), 0o644)
+
+	var calls atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		w := Watcher{Dirs: []string{dir}, Interval: 5 * time.Millisecond, Debounce: 50 * time.Millisecond}
+		done <- w.Run(ctx, func() { calls.Add(1) })
+	}()
+
+	waitFor(t, func() bool { return calls.Load() == 1 })
+	for i := range 3 {
+		os.WriteFile(path, []byte("package day09\n"+string(rune('a'+i))), 0o644)
+		time.Sleep(10 * time.Millisecond)
+	}
+	waitFor(t, func() bool { return calls.Load() == 2 })
+	time.Sleep(100 * time.Millisecond)
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("onChange called %d times; want 2 (initial run plus one per burst)", got)
+	}
+}
+
+// waitFor polls cond until it holds or a second has passed.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}